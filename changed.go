@@ -0,0 +1,218 @@
+package main
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var fieldmaskpbPackage = protogen.GoImportPath("google.golang.org/protobuf/types/known/fieldmaskpb")
+
+// genChangedFields generates ChangedFields methods, which walk the fields
+// like Equal and return the paths of the differing fields as a FieldMask.
+// Messages are descended into, producing dotted paths, while repeated and map
+// fields are reported as a whole. Unknown fields and extensions have no paths
+// and are reported only through the path of a nested message holding them.
+func genChangedFields(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bool) {
+	for _, m := range messages {
+
+		// Generate changed fields for nested messages
+		if len(m.Messages) > 0 {
+			genChangedFields(g, m.Messages, proto3)
+		}
+
+		// Do not generate extra message for map comparison
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+
+		// Skip messages disabled by options
+		if !isGenerated(m.Desc) {
+			continue
+		}
+
+		g.P()
+		g.P(`func (x *`, m.GoIdent, `) ChangedFields(y *`, m.GoIdent, `) *`, fieldmaskpbPackage.Ident("FieldMask"), ` {`)
+		g.P(`if x == y {`)
+		g.P(`return &`, fieldmaskpbPackage.Ident("FieldMask"), `{}`)
+		g.P(`}`)
+		// A nil message has the fields of an empty one
+		g.P(`if x == nil {`)
+		g.P(`x = &`, m.GoIdent, `{}`)
+		g.P(`}`)
+		g.P(`if y == nil {`)
+		g.P(`y = &`, m.GoIdent, `{}`)
+		g.P(`}`)
+		g.P(`var paths []string`)
+
+		for _, f := range m.Fields {
+
+			if isIgnored(f) {
+				continue
+			}
+
+			fieldName := f.GoName
+			path := strconv.Quote(string(f.Desc.Name()))
+			appendPath := `paths = append(paths, ` + path + `)`
+
+			switch {
+			case f.Oneof != nil && !f.Oneof.Desc.IsSynthetic():
+				// Oneof is compared as a whole at its first field
+				if f == f.Oneof.Fields[0] {
+					genChangedFieldsOneof(g, f.Oneof, proto3)
+				}
+
+			case f.Desc.IsList() && keyField(f) != nil:
+				g.P(`if !`, equalPackage.Ident("Keyed"), `(x.`, fieldName, `, y.`, fieldName, `, `, keyFunc(g, f), `, func(a, b `, goType(g, f), `) bool {`)
+				genEqualField(g, f, `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(appendPath)
+				g.P(`}`)
+
+			case f.Desc.IsList() && isUnordered(f):
+				g.P(`if !`, equalPackage.Ident("Unordered"), `(x.`, fieldName, `, y.`, fieldName, `, func(a, b `, goType(g, f), `) bool {`)
+				genEqualField(g, f, `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(appendPath)
+				g.P(`}`)
+
+			case f.Desc.IsList():
+				if eq := listEqual(g, f, `x.`+fieldName, `y.`+fieldName); eq != "" {
+					g.P(`if !`, eq, ` {`)
+					g.P(appendPath)
+					g.P(`}`)
+					break
+				}
+				g.P(`if len(x.`, fieldName, `) != len(y.`, fieldName, `) {`)
+				g.P(appendPath)
+				g.P(`} else {`)
+				g.P(`for i := 0; i < len(x.`, fieldName, `); i++ {`)
+				g.P(`if `, fieldNotEqual(g, f, `x.`+fieldName+`[i]`, `y.`+fieldName+`[i]`, proto3, true), ` {`)
+				g.P(appendPath)
+				g.P(`break`)
+				g.P(`}`)
+				g.P(`}`)
+				g.P(`}`)
+
+			case f.Desc.IsMap() && normalizesKeys(f):
+				g.P(`if !`, equalPackage.Ident("NormalizedMap"), `(x.`, fieldName, `, y.`, fieldName, `, `, stringFunc(g, stringMode(f.Message.Fields[0]), "%sString"), `, func(a, b `, goType(g, f.Message.Fields[1]), `) bool {`)
+				genEqualField(g, f.Message.Fields[1], `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(appendPath)
+				g.P(`}`)
+
+			case f.Desc.IsMap():
+				if eq := mapEqual(g, f, `x.`+fieldName, `y.`+fieldName); eq != "" {
+					g.P(`if !`, eq, ` {`)
+					g.P(appendPath)
+					g.P(`}`)
+					break
+				}
+				g.P(`if len(x.`, fieldName, `) != len(y.`, fieldName, `) {`)
+				g.P(appendPath)
+				g.P(`} else {`)
+				g.P(`for k := range x.`, fieldName, ` {`)
+				g.P(`if _, ok := y.`, fieldName, `[k]; !ok {`)
+				g.P(appendPath)
+				g.P(`break`)
+				g.P(`}`)
+				g.P(`if `, fieldNotEqual(g, f.Message.Fields[1], `x.`+fieldName+`[k]`, `y.`+fieldName+`[k]`, proto3, true), ` {`)
+				g.P(appendPath)
+				g.P(`break`)
+				g.P(`}`)
+				g.P(`}`)
+				g.P(`}`)
+
+			default:
+				genChangedField(g, f, `x.`+fieldName, `y.`+fieldName, proto3)
+			}
+		}
+
+		g.P(`return &`, fieldmaskpbPackage.Ident("FieldMask"), `{Paths: paths}`)
+		g.P(`}`)
+	}
+}
+
+// genChangedFieldsOneof reports the populated members of both messages when
+// they differ and the changes of the member otherwise.
+func genChangedFieldsOneof(g *protogen.GeneratedFile, oneof *protogen.Oneof, proto3 bool) {
+	oneofName := oneof.GoName
+
+	g.P(`switch xv := x.`, oneofName, `.(type) {`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(`if yv, ok := y.`, oneofName, `.(*`, f.GoIdent, `); !ok {`)
+		g.P(`paths = append(paths, `, strconv.Quote(string(f.Desc.Name())), `)`)
+		g.P(`} else {`)
+		genChangedField(g, f, `xv.`+f.GoName, `yv.`+f.GoName, proto3)
+		g.P(`}`)
+	}
+	g.P(`}`)
+
+	g.P(`switch y.`, oneofName, `.(type) {`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(`if _, ok := x.`, oneofName, `.(*`, f.GoIdent, `); !ok {`)
+		g.P(`paths = append(paths, `, strconv.Quote(string(f.Desc.Name())), `)`)
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
+
+// genChangedField appends the path of singular field f when values x and y
+// differ. Messages present in both are descended into when they have a
+// ChangedFields method, other values are reported as a whole.
+func genChangedField(g *protogen.GeneratedFile, f *protogen.Field, x, y string, proto3 bool) {
+	path := strconv.Quote(string(f.Desc.Name()))
+
+	if (f.Desc.Kind() == protoreflect.MessageKind || f.Desc.Kind() == protoreflect.GroupKind) && !isWellKnownType(f.Message) {
+		if isLocalMessage(f.Message) {
+			notEqual := ""
+			if params.unknown != unknownIgnore || f.Message.Desc.ExtensionRanges().Len() > 0 {
+				notEqual = `!p.` + params.method + `(q)`
+			}
+			g.P(`if p, q := `, x, `, `, y, `; p == nil || q == nil {`)
+			g.P(`if p != q {`)
+			g.P(`paths = append(paths, `, path, `)`)
+			g.P(`}`)
+			genChangedNested(g, `} else `, path, `p.ChangedFields(q)`, notEqual)
+			g.P(`}`)
+			return
+		}
+
+		// Use generated ChangedFields when the foreign message has one
+		notEqual := fieldNotEqual(g, f, x, y, proto3, false)
+		g.P(`if m, ok := interface{}(`, x, `).(interface { ChangedFields(*`, f.Message.GoIdent, `) *`, fieldmaskpbPackage.Ident("FieldMask"), ` }); ok && `, x, ` != nil && `, y, ` != nil {`)
+		genChangedNested(g, ``, path, `m.ChangedFields(`+y+`)`, notEqual)
+		g.P(`}`)
+		g.P(`} else if `, notEqual, ` {`)
+		g.P(`paths = append(paths, `, path, `)`)
+		g.P(`}`)
+		return
+	}
+
+	g.P(`if `, fieldNotEqual(g, f, x, y, proto3, false), ` {`)
+	g.P(`paths = append(paths, `, path, `)`)
+	g.P(`}`)
+}
+
+// genChangedNested appends the paths of nested, the changed fields of a
+// message at path, below path, leaving the last block open after prefix.
+// Unknown fields and extensions have no paths, so a message without changed
+// fields is reported as a whole when notEqual holds.
+func genChangedNested(g *protogen.GeneratedFile, prefix, path, nested, notEqual string) {
+	appendNestedPaths := g.QualifiedGoIdent(equalPackage.Ident("AppendNestedPaths"))
+	if notEqual == "" {
+		g.P(prefix, `{`)
+		g.P(`paths = `, appendNestedPaths, `(paths, `, path, `, `, nested, `)`)
+		return
+	}
+	g.P(prefix, `if nested := `, nested, `; len(nested.GetPaths()) > 0 {`)
+	g.P(`paths = `, appendNestedPaths, `(paths, `, path, `, nested)`)
+	g.P(`} else if `, notEqual, ` {`)
+	g.P(`paths = append(paths, `, path, `)`)
+}
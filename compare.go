@@ -0,0 +1,315 @@
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/melias122/protoc-gen-go-equal/equal"
+)
+
+var bytesPackage = protogen.GoImportPath("bytes")
+
+// genCompare generates Compare methods, which walk the fields like Equal and
+// return -1, 0 or +1 at the first difference. Compare returns 0 exactly when
+// Equal returns true and orders messages by fields in declaration order:
+//   - nil messages and unset fields sort first,
+//   - repeated fields are ordered lexicographically,
+//   - maps are walked in sorted key order, a map having a key the other lacks sorts first,
+//   - oneofs are ordered by the field number of the set member, then by its value.
+func genCompare(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bool) {
+	for _, m := range messages {
+
+		// Generate compare for nested messages
+		if len(m.Messages) > 0 {
+			genCompare(g, m.Messages, proto3)
+		}
+
+		// Do not generate extra message for map entries
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+
+		// Skip messages disabled by options
+		if !isGenerated(m.Desc) {
+			continue
+		}
+
+		g.P()
+		g.P(`func (x *`, m.GoIdent, `) Compare(y *`, m.GoIdent, `) int {`)
+		g.P(`if x == y {`)
+		g.P(`return 0`)
+		g.P(`}`)
+		g.P(`if x == nil {`)
+		g.P(`return -1`)
+		g.P(`}`)
+		g.P(`if y == nil {`)
+		g.P(`return 1`)
+		g.P(`}`)
+
+		for _, f := range m.Fields {
+
+			if isIgnored(f) {
+				continue
+			}
+
+			fieldName := f.GoName
+
+			switch {
+			case f.Oneof != nil && !f.Oneof.Desc.IsSynthetic():
+				// Oneof is compared as a whole at its first field
+				if f == f.Oneof.Fields[0] {
+					genCompareOneof(g, f.Oneof, proto3)
+				}
+
+			case f.Desc.IsList() && keyField(f) != nil:
+				compareKey := g.QualifiedGoIdent(equalPackage.Ident("CompareOrdered")) + `[` + keyType(g, keyField(f)) + `]`
+				if keyField(f).Desc.Kind() == protoreflect.BoolKind {
+					compareKey = g.QualifiedGoIdent(equalPackage.Ident("CompareBool"))
+				}
+				g.P(`if c := `, equalPackage.Ident("CompareKeyed"), `(x.`, fieldName, `, y.`, fieldName, `, `, keyFunc(g, f), `, `, compareKey, `, func(a, b `, goType(g, f), `) int {`)
+				genCompareField(g, f, `a`, `b`, proto3, true)
+				g.P(`return 0`)
+				g.P(`}); c != 0 {`)
+				g.P(`return c`)
+				g.P(`}`)
+
+			case f.Desc.IsList() && isUnordered(f):
+				g.P(`if c := `, equalPackage.Ident("CompareUnordered"), `(x.`, fieldName, `, y.`, fieldName, `, func(a, b `, goType(g, f), `) int {`)
+				genCompareField(g, f, `a`, `b`, proto3, true)
+				g.P(`return 0`)
+				g.P(`}); c != 0 {`)
+				g.P(`return c`)
+				g.P(`}`)
+
+			case f.Desc.IsList():
+				g.P(`for i := 0; i < len(x.`, fieldName, `) && i < len(y.`, fieldName, `); i++ {`)
+				genCompareField(g, f, `x.`+fieldName+`[i]`, `y.`+fieldName+`[i]`, proto3, true)
+				g.P(`}`)
+				g.P(`if c := `, equalPackage.Ident("CompareOrdered"), `(len(x.`, fieldName, `), len(y.`, fieldName, `)); c != 0 {`)
+				g.P(`return c`)
+				g.P(`}`)
+
+			case f.Desc.IsMap() && normalizesKeys(f):
+				g.P(`if c := `, equalPackage.Ident("CompareNormalizedMap"), `(x.`, fieldName, `, y.`, fieldName, `, `, stringFunc(g, stringMode(f.Message.Fields[0]), "%sString"), `, func(a, b `, goType(g, f.Message.Fields[1]), `) int {`)
+				genCompareField(g, f.Message.Fields[1], `a`, `b`, proto3, true)
+				g.P(`return 0`)
+				g.P(`}); c != 0 {`)
+				g.P(`return c`)
+				g.P(`}`)
+
+			case f.Desc.IsMap():
+				sortedKeys := equalPackage.Ident("SortedKeys")
+				if f.Message.Fields[0].Desc.Kind() == protoreflect.BoolKind {
+					sortedKeys = equalPackage.Ident("SortedBoolKeys")
+				}
+				g.P(`for _, k := range `, sortedKeys, `(x.`, fieldName, `, y.`, fieldName, `) {`)
+				g.P(`xv, xok := x.`, fieldName, `[k]`)
+				g.P(`yv, yok := y.`, fieldName, `[k]`)
+				g.P(`if xok != yok {`)
+				g.P(`return `, equalPackage.Ident("CompareBool"), `(yok, xok)`)
+				g.P(`}`)
+				genCompareField(g, f.Message.Fields[1], `xv`, `yv`, proto3, true)
+				g.P(`}`)
+
+			default:
+				genCompareField(g, f, `x.`+fieldName, `y.`+fieldName, proto3, false)
+			}
+		}
+
+		if m.Desc.ExtensionRanges().Len() > 0 {
+			genExtensionsCall(g, m, `Compare`, `int`, equalPackage.Ident("CompareMessages"), `if c := `+g.QualifiedGoIdent(equalPackage.Ident("CompareExtensions"))+`(x.ProtoReflect(), y.ProtoReflect(), `+extensionsFloatMode(g)+`, `, `); c != 0 {`)
+			g.P(`return c`)
+			g.P(`}`)
+		}
+
+		switch params.unknown {
+		case unknownRaw:
+			g.P(`if c := `, bytesPackage.Ident("Compare"), `(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {`)
+			g.P(`return c`)
+			g.P(`}`)
+		case unknownCanonical:
+			g.P(`if c := `, equalPackage.Ident("CompareUnknownFields"), `(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {`)
+			g.P(`return c`)
+			g.P(`}`)
+		}
+
+		g.P(`return 0`)
+		g.P(`}`)
+	}
+}
+
+// genCompareOneof orders oneof by the field number of the set member (0 when
+// unset) and then by the member values.
+func genCompareOneof(g *protogen.GeneratedFile, oneof *protogen.Oneof, proto3 bool) {
+	oneofName := oneof.GoName
+
+	g.P(`switch xv := x.`, oneofName, `.(type) {`)
+	g.P(`case nil:`)
+	g.P(`if y.`, oneofName, ` != nil {`)
+	g.P(`return -1`)
+	g.P(`}`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(`switch yv := y.`, oneofName, `.(type) {`)
+		g.P(`case *`, f.GoIdent, `:`)
+		genCompareField(g, f, `xv.`+f.GoName, `yv.`+f.GoName, proto3, false)
+		for _, other := range oneof.Fields {
+			if other.Desc.Number() < f.Desc.Number() {
+				g.P(`case *`, other.GoIdent, `:`)
+				g.P(`return 1`)
+			}
+		}
+		g.P(`case nil:`)
+		g.P(`return 1`)
+		g.P(`default:`)
+		g.P(`return -1`)
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
+
+// genCompareField returns the order of values x and y of field f when they differ.
+func genCompareField(g *protogen.GeneratedFile, f *protogen.Field, x, y string, proto3 bool, repeated bool) {
+	nullable, oneof := fieldPresence(f, proto3, repeated)
+	compareBool := g.QualifiedGoIdent(equalPackage.Ident("CompareBool"))
+
+	printCompare := func(expr string) {
+		g.P(`if c := `, expr, `; c != 0 {`)
+		g.P(`return c`)
+		g.P(`}`)
+	}
+
+	// Floats within (equal.field).tolerance compare as equal and strings are
+	// ordered under (equal.field).string_mode
+	printCompareScalar := func(kind protoreflect.Kind, a, b string, implicitPresence bool) {
+		if mode := stringMode(f); mode != equal.StringMode_STRING_MODE_EXACT {
+			printCompare(stringFunc(g, mode, "CompareString%s") + `(` + a + `, ` + b + `)`)
+			return
+		}
+		tolerance := floatTolerance(f)
+		if tolerance == nil {
+			printCompare(compareValue(g, kind, a, b, implicitPresence))
+			return
+		}
+		g.P(`if c := `, compareValue(g, kind, a, b, implicitPresence), `; c != 0 && !`, floatNear(g, tolerance, kind, a, b), ` {`)
+		g.P(`return c`)
+		g.P(`}`)
+	}
+
+	switch f.Desc.Kind() {
+	case protoreflect.BytesKind:
+		if nullable {
+			printCompare(compareBool + `(` + x + ` != nil, ` + y + ` != nil)`)
+		}
+		if contentType(f) != "" {
+			printCompare(contentFunc(g, f, "Compare%s", x, y))
+			return
+		}
+		printCompare(g.QualifiedGoIdent(bytesPackage.Ident("Compare")) + `(` + x + `, ` + y + `)`)
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if isLocalMessage(f.Message) && !isWellKnownType(f.Message) {
+			printCompare(x + `.Compare(` + y + `)`)
+			return
+		}
+
+		printCompare(compareBool + `(` + x + ` != nil, ` + y + ` != nil)`)
+
+		switch f.Message.Location.SourceFile {
+		case "google/protobuf/any.proto":
+			g.P(`if p, q := `, x, `, `, y, `; p != nil {`)
+			switch {
+			case params.any == anyUnpack && isAnyTypeName(f):
+				printCompare(g.QualifiedGoIdent(equalPackage.Ident("CompareAnyName")) + `(p, q)`)
+			case params.any == anyUnpack:
+				printCompare(g.QualifiedGoIdent(equalPackage.Ident("CompareAny")) + `(p, q)`)
+			case isAnyTypeName(f):
+				typeName := g.QualifiedGoIdent(equalPackage.Ident("AnyTypeName"))
+				printCompare(compareValue(g, protoreflect.StringKind, typeName+`(p.TypeUrl)`, typeName+`(q.TypeUrl)`, false))
+				printCompare(compareValue(g, protoreflect.BytesKind, `p.Value`, `q.Value`, false))
+			default:
+				printCompare(compareValue(g, protoreflect.StringKind, `p.TypeUrl`, `q.TypeUrl`, false))
+				printCompare(compareValue(g, protoreflect.BytesKind, `p.Value`, `q.Value`, false))
+			}
+			g.P(`}`)
+
+		case "google/protobuf/duration.proto", "google/protobuf/timestamp.proto":
+			g.P(`if p, q := `, x, `, `, y, `; p != nil {`)
+			switch tolerance, ok := timeTolerance(f); {
+			case ok:
+				// Values within (equal.field).time_tolerance compare as equal
+				g.P(`if c := `, equalPackage.Ident("CompareTime"), `(p.Seconds, p.Nanos, q.Seconds, q.Nanos); c != 0 && !`, equalPackage.Ident("TimeNear"), `(p.Seconds, p.Nanos, q.Seconds, q.Nanos, `, int64(tolerance), `) {`)
+				g.P(`return c`)
+				g.P(`}`)
+			case params.time == timeNormalized:
+				printCompare(g.QualifiedGoIdent(equalPackage.Ident("CompareTime")) + `(p.Seconds, p.Nanos, q.Seconds, q.Nanos)`)
+			default:
+				printCompare(compareValue(g, protoreflect.Int64Kind, `p.Seconds`, `q.Seconds`, false))
+				printCompare(compareValue(g, protoreflect.Int32Kind, `p.Nanos`, `q.Nanos`, false))
+			}
+			g.P(`}`)
+
+		case "google/protobuf/struct.proto":
+			printCompare(g.QualifiedGoIdent(equalPackage.Ident("Compare"+f.Message.GoIdent.GoName)) + `(` + x + `, ` + y + `, ` + unknownMode(g) + `)`)
+
+		case "google/protobuf/empty.proto":
+
+		case "google/protobuf/wrappers.proto":
+			g.P(`if p, q := `, x, `, `, y, `; p != nil {`)
+			printCompareScalar(f.Message.Fields[0].Desc.Kind(), `p.Value`, `q.Value`, true)
+			g.P(`}`)
+
+		case "google/protobuf/field_mask.proto":
+			if params.fieldMask == fieldMaskNormalized {
+				printCompare(g.QualifiedGoIdent(equalPackage.Ident("CompareFieldMask")) + `(` + x + `, ` + y + `, ` + unknownMode(g) + `)`)
+				return
+			}
+			fallthrough
+
+		default:
+			// Use generated Compare when the foreign message has one,
+			// otherwise fallback to equal.CompareMessages
+			g.P(`if m, ok := interface{}(`, x, `).(interface { Compare(*`, f.Message.GoIdent, `) int }); ok {`)
+			printCompare(`m.Compare(` + y + `)`)
+			g.P(`} else if `, x, ` != nil {`)
+			printCompare(g.QualifiedGoIdent(equalPackage.Ident("CompareMessages")) + `(` + x + `, ` + y + `)`)
+			g.P(`}`)
+		}
+
+	default:
+		if nullable {
+			printCompare(compareBool + `(` + x + ` != nil, ` + y + ` != nil)`)
+			g.P(`if p, q := `, x, `, `, y, `; p != nil {`)
+			printCompareScalar(f.Desc.Kind(), `*p`, `*q`, false)
+			g.P(`}`)
+			return
+		}
+		printCompareScalar(f.Desc.Kind(), x, y, !repeated && !oneof)
+	}
+}
+
+// compareValue returns an expression ordering scalars a and b of the given
+// kind. Floats are ordered consistently with floatNotEqual.
+func compareValue(g *protogen.GeneratedFile, kind protoreflect.Kind, a, b string, implicitPresence bool) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return g.QualifiedGoIdent(equalPackage.Ident("CompareBool")) + `(` + a + `, ` + b + `)`
+
+	case protoreflect.BytesKind:
+		return g.QualifiedGoIdent(bytesPackage.Ident("Compare")) + `(` + a + `, ` + b + `)`
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		switch {
+		case params.float == floatBits && kind == protoreflect.FloatKind:
+			return g.QualifiedGoIdent(equalPackage.Ident("CompareFloat32Bits")) + `(` + a + `, ` + b + `)`
+		case params.float == floatBits:
+			return g.QualifiedGoIdent(equalPackage.Ident("CompareFloat64Bits")) + `(` + a + `, ` + b + `)`
+		case params.float == floatProto && implicitPresence:
+			return g.QualifiedGoIdent(equalPackage.Ident("CompareFloat64SignedZero")) + `(float64(` + a + `), float64(` + b + `))`
+		}
+		return g.QualifiedGoIdent(equalPackage.Ident("CompareFloat64")) + `(float64(` + a + `), float64(` + b + `))`
+
+	default:
+		return g.QualifiedGoIdent(equalPackage.Ident("CompareOrdered")) + `(` + a + `, ` + b + `)`
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genDiff generates Diff methods, which walk the fields like Equal but
+// collect every difference with its field path instead of returning early.
+func genDiff(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bool) {
+	for _, m := range messages {
+
+		// Generate diff for nested messages
+		if len(m.Messages) > 0 {
+			genDiff(g, m.Messages, proto3)
+		}
+
+		// Do not generate extra message for map comparison
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+
+		// Skip messages disabled by options
+		if !isGenerated(m.Desc) {
+			continue
+		}
+
+		difference := g.QualifiedGoIdent(equalPackage.Ident("Difference"))
+
+		g.P()
+		g.P(`func (x *`, m.GoIdent, `) Diff(y *`, m.GoIdent, `) []`, difference, ` {`)
+		g.P(`if x == y {`)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P(`if x == nil {`)
+		g.P(`return []`, difference, `{{Y: y}}`)
+		g.P(`}`)
+		g.P(`if y == nil {`)
+		g.P(`return []`, difference, `{{X: x}}`)
+		g.P(`}`)
+		g.P(`var d []`, difference)
+
+		for _, f := range m.Fields {
+
+			if isIgnored(f) {
+				continue
+			}
+
+			fieldName := f.GoName
+			path := strconv.Quote(string(f.Desc.Name()))
+
+			switch {
+			case f.Oneof != nil && !f.Oneof.Desc.IsSynthetic():
+				// Oneof is compared as a whole at its first field
+				if f == f.Oneof.Fields[0] {
+					genDiffOneof(g, f.Oneof, proto3)
+				}
+
+			case f.Desc.IsList() && keyField(f) != nil:
+				// Report matched elements at their key
+				g.P(`for _, match := range `, equalPackage.Ident("MatchKeyed"), `(x.`, fieldName, `, y.`, fieldName, `, `, keyFunc(g, f), `) {`)
+				g.P(`switch {`)
+				g.P(`case match.Y < 0:`)
+				g.P(`d = append(d, `, difference, `{Path: match.Path(`, path, `), X: x.`, fieldName, `[match.X]})`)
+				g.P(`case match.X < 0:`)
+				g.P(`d = append(d, `, difference, `{Path: match.Path(`, path, `), Y: y.`, fieldName, `[match.Y]})`)
+				g.P(`default:`)
+				genDiffField(g, f, `x.`+fieldName+`[match.X]`, `y.`+fieldName+`[match.Y]`, `match.Path(`+path+`)`, proto3, true)
+				g.P(`}`)
+				g.P(`}`)
+
+			case f.Desc.IsList() && isUnordered(f):
+				// Elements have no path in multisets, report the whole field
+				g.P(`if !`, equalPackage.Ident("Unordered"), `(x.`, fieldName, `, y.`, fieldName, `, func(a, b `, goType(g, f), `) bool {`)
+				genEqualField(g, f, `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(`d = append(d, `, difference, `{Path: `, path, `, X: x.`, fieldName, `, Y: y.`, fieldName, `})`)
+				g.P(`}`)
+
+			case f.Desc.IsList():
+				g.P(`if len(x.`, fieldName, `) != len(y.`, fieldName, `) {`)
+				g.P(`d = append(d, `, difference, `{Path: `, path, `, X: x.`, fieldName, `, Y: y.`, fieldName, `})`)
+				g.P(`} else {`)
+				g.P(`for i := 0; i < len(x.`, fieldName, `); i++ {`)
+
+				genDiffField(g, f, `x.`+fieldName+`[i]`, `y.`+fieldName+`[i]`, g.QualifiedGoIdent(equalPackage.Ident("Index"))+`(`+path+`, i)`, proto3, true)
+
+				g.P(`}`)
+				g.P(`}`)
+
+			case f.Desc.IsMap() && normalizesKeys(f):
+				// Keys may differ in normalized maps, report the whole field
+				g.P(`if !`, equalPackage.Ident("NormalizedMap"), `(x.`, fieldName, `, y.`, fieldName, `, `, stringFunc(g, stringMode(f.Message.Fields[0]), "%sString"), `, func(a, b `, goType(g, f.Message.Fields[1]), `) bool {`)
+				genEqualField(g, f.Message.Fields[1], `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(`d = append(d, `, difference, `{Path: `, path, `, X: x.`, fieldName, `, Y: y.`, fieldName, `})`)
+				g.P(`}`)
+
+			case f.Desc.IsMap():
+				key := g.QualifiedGoIdent(equalPackage.Ident("Key")) + `(` + path + `, k)`
+
+				g.P(`for k := range x.`, fieldName, ` {`)
+				g.P(`if _, ok := y.`, fieldName, `[k]; !ok {`)
+				g.P(`d = append(d, `, difference, `{Path: `, key, `, X: x.`, fieldName, `[k]})`)
+				g.P(`continue`)
+				g.P(`}`)
+
+				genDiffField(g, f.Message.Fields[1], `x.`+fieldName+`[k]`, `y.`+fieldName+`[k]`, key, proto3, true)
+
+				g.P(`}`)
+				g.P(`for k := range y.`, fieldName, ` {`)
+				g.P(`if _, ok := x.`, fieldName, `[k]; !ok {`)
+				g.P(`d = append(d, `, difference, `{Path: `, key, `, Y: y.`, fieldName, `[k]})`)
+				g.P(`}`)
+				g.P(`}`)
+
+			default:
+				genDiffField(g, f, `x.`+fieldName, `y.`+fieldName, path, proto3, false)
+			}
+		}
+
+		if m.Desc.ExtensionRanges().Len() > 0 {
+			genExtensionsCall(g, m, params.method, `bool`, protoPackage.Ident("Equal"), `d = append(d, `+g.QualifiedGoIdent(equalPackage.Ident("DiffExtensions"))+`(x.ProtoReflect(), y.ProtoReflect(), `+extensionsFloatMode(g)+`, `, `)...)`)
+		}
+
+		if cond := unknownNotEqual(g); cond != "" {
+			g.P(`if `, cond, ` {`)
+			g.P(`d = append(d, `, difference, `{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})`)
+			g.P(`}`)
+		}
+
+		g.P(`return d`)
+		g.P(`}`)
+	}
+}
+
+// genDiffOneof reports the whole oneof when different members are populated
+// and the member difference otherwise.
+func genDiffOneof(g *protogen.GeneratedFile, oneof *protogen.Oneof, proto3 bool) {
+	oneofName := oneof.GoName
+	difference := g.QualifiedGoIdent(equalPackage.Ident("Difference"))
+	path := strconv.Quote(string(oneof.Desc.Name()))
+
+	g.P(`switch xv := x.`, oneofName, `.(type) {`)
+	g.P(`case nil:`)
+	g.P(`if y.`, oneofName, ` != nil {`)
+	g.P(`d = append(d, `, difference, `{Path: `, path, `, Y: y.`, oneofName, `})`)
+	g.P(`}`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(`if yv, ok := y.`, oneofName, `.(*`, f.GoIdent, `); !ok {`)
+		g.P(`d = append(d, `, difference, `{Path: `, path, `, X: x.`, oneofName, `, Y: y.`, oneofName, `})`)
+		g.P(`} else {`)
+
+		genDiffField(g, f, `xv.`+f.GoName, `yv.`+f.GoName, strconv.Quote(string(f.Desc.Name())), proto3, false)
+
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
+
+// genDiffField appends the difference of values x and y of field f at path.
+// Local messages are descended into, other values are reported as a whole.
+func genDiffField(g *protogen.GeneratedFile, f *protogen.Field, x, y, path string, proto3 bool, repeated bool) {
+	difference := g.QualifiedGoIdent(equalPackage.Ident("Difference"))
+	appendNested := g.QualifiedGoIdent(equalPackage.Ident("AppendNested"))
+
+	if (f.Desc.Kind() == protoreflect.MessageKind || f.Desc.Kind() == protoreflect.GroupKind) && !isWellKnownType(f.Message) {
+		if isLocalMessage(f.Message) {
+			g.P(`d = `, appendNested, `(d, `, path, `, `, x, `.Diff(`, y, `))`)
+			return
+		}
+
+		// Use generated Diff when the foreign message has one
+		g.P(`if m, ok := interface{}(`, x, `).(interface { Diff(*`, f.Message.GoIdent, `) []`, difference, ` }); ok {`)
+		g.P(`d = `, appendNested, `(d, `, path, `, m.Diff(`, y, `))`)
+		g.P(`} else if !`, protoPackage.Ident("Equal"), `(`, x, `, `, y, `) {`)
+		g.P(`d = append(d, `, difference, `{Path: `, path, `, X: `, x, `, Y: `, y, `})`)
+		g.P(`}`)
+		return
+	}
+
+	g.P(`if `, fieldNotEqual(g, f, x, y, proto3, repeated), ` {`)
+	g.P(`d = append(d, `, difference, `{Path: `, path, `, X: `, x, `, Y: `, y, `})`)
+	g.P(`}`)
+}
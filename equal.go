@@ -1,13 +1,19 @@
 package main
 
 import (
+	"strconv"
+
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/melias122/protoc-gen-go-equal/equal"
 )
 
 var (
-	mathPackage  = protogen.GoImportPath("math")
-	protoPackage = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	mathPackage         = protogen.GoImportPath("math")
+	protoPackage        = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	protoreflectPackage = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+	equalPackage        = protogen.GoImportPath("github.com/melias122/protoc-gen-go-equal/equal")
 )
 
 func genEqual(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bool) {
@@ -23,8 +29,13 @@ func genEqual(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bo
 			continue
 		}
 
+		// Skip messages disabled by options
+		if !isGenerated(m.Desc) {
+			continue
+		}
+
 		g.P()
-		g.P(`func (x *`, m.GoIdent, `) Equal(y *`, m.GoIdent, `) bool {`)
+		g.P(`func (x *`, m.GoIdent, `) `, params.method, `(y *`, m.GoIdent, `) bool {`)
 
 		// Avoid comparison if both inputs are identical pointers
 		g.P(`if x == y {`)
@@ -41,20 +52,69 @@ func genEqual(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bo
 		// Generate fields comparison
 		for _, f := range m.Fields {
 
+			if isIgnored(f) {
+				continue
+			}
+
 			fieldName := f.GoName
 
 			switch {
+			case f.Oneof != nil && !f.Oneof.Desc.IsSynthetic():
+				// Oneof is compared as a whole at its first field
+				if f == f.Oneof.Fields[0] {
+					genEqualOneof(g, f.Oneof, proto3)
+				}
+
+			case f.Desc.IsList() && keyField(f) != nil:
+				// Match elements by key
+				g.P(`if !`, equalPackage.Ident("Keyed"), `(x.`, fieldName, `, y.`, fieldName, `, `, keyFunc(g, f), `, func(a, b `, goType(g, f), `) bool {`)
+				genEqualField(g, f, `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(`return false`)
+				g.P(`}`)
+
+			case f.Desc.IsList() && isUnordered(f):
+				// Compare as multisets
+				g.P(`if !`, equalPackage.Ident("Unordered"), `(x.`, fieldName, `, y.`, fieldName, `, func(a, b `, goType(g, f), `) bool {`)
+				genEqualField(g, f, `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(`return false`)
+				g.P(`}`)
+
 			case f.Desc.IsList():
+				if eq := listEqual(g, f, `x.`+fieldName, `y.`+fieldName); eq != "" {
+					g.P(`if !`, eq, ` {`)
+					g.P(`return false`)
+					g.P(`}`)
+					break
+				}
 				g.P(`if len(x.` + fieldName + `) != len(y.` + fieldName + `) {`)
 				g.P(`return false`)
 				g.P(`}`)
 				g.P(`for i := 0; i < len(x.` + fieldName + `); i++ {`)
 
-				genEqualField(g, f, fieldName+`[i]`, proto3, true)
+				genEqualField(g, f, `x.`+fieldName+`[i]`, `y.`+fieldName+`[i]`, proto3, true)
 
 				g.P(`}`)
 
+			case f.Desc.IsMap() && normalizesKeys(f):
+				// Match entries by normalized keys
+				g.P(`if !`, equalPackage.Ident("NormalizedMap"), `(x.`, fieldName, `, y.`, fieldName, `, `, stringFunc(g, stringMode(f.Message.Fields[0]), "%sString"), `, func(a, b `, goType(g, f.Message.Fields[1]), `) bool {`)
+				genEqualField(g, f.Message.Fields[1], `a`, `b`, proto3, true)
+				g.P(`return true`)
+				g.P(`}) {`)
+				g.P(`return false`)
+				g.P(`}`)
+
 			case f.Desc.IsMap():
+				if eq := mapEqual(g, f, `x.`+fieldName, `y.`+fieldName); eq != "" {
+					g.P(`if !`, eq, ` {`)
+					g.P(`return false`)
+					g.P(`}`)
+					break
+				}
 				g.P(`if len(x.` + fieldName + `) != len(y.` + fieldName + `) {`)
 				g.P(`return false`)
 				g.P(`}`)
@@ -64,29 +124,157 @@ func genEqual(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bo
 				g.P(`return false`)
 				g.P(`}`)
 
-				genEqualField(g, f.Message.Fields[1], fieldName+`[k]`, proto3, true)
+				genEqualField(g, f.Message.Fields[1], `x.`+fieldName+`[k]`, `y.`+fieldName+`[k]`, proto3, true)
 
 				g.P(`}`)
 
 			default:
-				genEqualField(g, f, fieldName, proto3, false)
+				genEqualField(g, f, `x.`+fieldName, `y.`+fieldName, proto3, false)
 			}
 		}
 
+		if m.Desc.ExtensionRanges().Len() > 0 {
+			genEqualExtensions(g, m)
+		}
+
+		genEqualUnknown(g)
+
 		g.P(`return true`)
 		g.P(`}`)
 	}
 }
 
-func genEqualField(g *protogen.GeneratedFile, f *protogen.Field, fieldName string, proto3 bool, repeated bool) {
-	// Some of these lines are stolen from vtprotobuf equal
-	oneof := f.Oneof != nil && !f.Oneof.Desc.IsSynthetic() || (f.Desc.ContainingOneof() != nil && !proto3)
-	nullable := (f.Message != nil || (f.Oneof != nil && f.Oneof.Desc.IsSynthetic()) || (!proto3 && !oneof)) && !repeated
+// genEqualRegistry registers the generated Equal methods of messages in an
+// init function, so equal.Messages uses them for messages of unknown types.
+func genEqualRegistry(g *protogen.GeneratedFile, messages []*protogen.Message) {
+	g.P()
+	g.P(`func init() {`)
+	genRegister(g, messages)
+	g.P(`}`)
+}
 
-	x, y := "x."+fieldName, "y."+fieldName
-	if oneof {
-		x, y = "x.Get"+fieldName+"()", "y.Get"+fieldName+"()"
+func genRegister(g *protogen.GeneratedFile, messages []*protogen.Message) {
+	for _, m := range messages {
+		if !m.Desc.IsMapEntry() && isGenerated(m.Desc) {
+			g.P(equalPackage.Ident("Register"), `(`, strconv.Quote(string(m.Desc.FullName())), `, (*`, m.GoIdent, `).`, params.method, `)`)
+		}
+		genRegister(g, m.Messages)
 	}
+}
+
+// genEqualExtensions compares populated extension fields.
+func genEqualExtensions(g *protogen.GeneratedFile, m *protogen.Message) {
+	genExtensionsCall(g, m, params.method, `bool`, protoPackage.Ident("Equal"), `if !`+g.QualifiedGoIdent(equalPackage.Ident("Extensions"))+`(x.ProtoReflect(), y.ProtoReflect(), `+extensionsFloatMode(g)+`, `, `) {`)
+	g.P(`return false`)
+	g.P(`}`)
+}
+
+// genExtensionsCall prints prefix and suffix around the function comparing
+// message values of extensions of m with method, which returns result.
+// Message values of extensions declared in local packages are compared with
+// the generated method, other values fall back to fallback.
+func genExtensionsCall(g *protogen.GeneratedFile, m *protogen.Message, method, result string, fallback protogen.GoIdent, prefix, suffix string) {
+	var localMessages []*protogen.Message
+	seen := make(map[protoreflect.FullName]bool)
+	for _, e := range localExtensions[m.Desc.FullName()] {
+		if e.Message == nil || seen[e.Message.Desc.FullName()] || !isLocalMessage(e.Message) {
+			continue
+		}
+		seen[e.Message.Desc.FullName()] = true
+		localMessages = append(localMessages, e.Message)
+	}
+
+	if len(localMessages) == 0 {
+		g.P(prefix, `nil`, suffix)
+		return
+	}
+
+	g.P(prefix, `func(p, q `, protoreflectPackage.Ident("Message"), `) `, result, ` {`)
+	g.P(`switch v := p.Interface().(type) {`)
+	for _, lm := range localMessages {
+		g.P(`case *`, lm.GoIdent, `:`)
+		g.P(`return v.`, method, `(q.Interface().(*`, lm.GoIdent, `))`)
+	}
+	g.P(`}`)
+	g.P(`return `, fallback, `(p.Interface(), q.Interface())`)
+	g.P(`}`, suffix)
+}
+
+// extensionsFloatMode returns the equal.FloatMode of extension values
+// matching the float parameter.
+func extensionsFloatMode(g *protogen.GeneratedFile) string {
+	if params.float == floatBits {
+		return g.QualifiedGoIdent(equalPackage.Ident("FloatBits"))
+	}
+	return g.QualifiedGoIdent(equalPackage.Ident("FloatNumeric"))
+}
+
+// unknownMode returns the equal.UnknownMode matching the unknown parameter,
+// passed to the helpers comparing well-known types.
+func unknownMode(g *protogen.GeneratedFile) string {
+	switch params.unknown {
+	case unknownRaw:
+		return g.QualifiedGoIdent(equalPackage.Ident("UnknownRaw"))
+	case unknownIgnore:
+		return g.QualifiedGoIdent(equalPackage.Ident("UnknownIgnore"))
+	}
+	return g.QualifiedGoIdent(equalPackage.Ident("UnknownCanonical"))
+}
+
+// genEqualUnknown compares unknown fields according to the unknown parameter.
+func genEqualUnknown(g *protogen.GeneratedFile) {
+	if cond := unknownNotEqual(g); cond != "" {
+		g.P(`if `, cond, ` {`)
+		g.P(`return false`)
+		g.P(`}`)
+	}
+}
+
+// unknownNotEqual returns an expression reporting whether unknown fields of
+// x and y differ, or an empty string when unknown fields are ignored.
+func unknownNotEqual(g *protogen.GeneratedFile) string {
+	switch params.unknown {
+	case unknownRaw:
+		return `string(x.ProtoReflect().GetUnknown()) != string(y.ProtoReflect().GetUnknown())`
+	case unknownCanonical:
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("UnknownFields")) + `(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown())`
+	}
+	return ""
+}
+
+// genEqualOneof compares which member of the oneof is populated on each
+// side and only then compares the member values, so that a member set to its
+// zero value is not equal to an unset oneof.
+func genEqualOneof(g *protogen.GeneratedFile, oneof *protogen.Oneof, proto3 bool) {
+	oneofName := oneof.GoName
+
+	g.P(`switch xv := x.`, oneofName, `.(type) {`)
+	g.P(`case nil:`)
+	g.P(`if y.`, oneofName, ` != nil {`)
+	g.P(`return false`)
+	g.P(`}`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(`yv, ok := y.`, oneofName, `.(*`, f.GoIdent, `)`)
+		g.P(`if !ok {`)
+		g.P(`return false`)
+		g.P(`}`)
+
+		genEqualField(g, f, `xv.`+f.GoName, `yv.`+f.GoName, proto3, false)
+	}
+	g.P(`}`)
+}
+
+func genEqualField(g *protogen.GeneratedFile, f *protogen.Field, x, y string, proto3 bool, repeated bool) {
+	g.P(`if `, fieldNotEqual(g, f, x, y, proto3, repeated), ` {`)
+	g.P(`return false`)
+	g.P(`}`)
+}
+
+// fieldNotEqual returns the header of an if statement, which reports whether
+// values x and y of field f differ.
+func fieldNotEqual(g *protogen.GeneratedFile, f *protogen.Field, x, y string, proto3 bool, repeated bool) string {
+	nullable, oneof := fieldPresence(f, proto3, repeated)
 
 	switch f.Desc.Kind() {
 	case protoreflect.BoolKind, protoreflect.EnumKind,
@@ -96,79 +284,278 @@ func genEqualField(g *protogen.GeneratedFile, f *protogen.Field, fieldName strin
 		protoreflect.Uint32Kind, protoreflect.Uint64Kind,
 		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind,
 		protoreflect.StringKind:
+		if mode := stringMode(f); mode != equal.StringMode_STRING_MODE_EXACT {
+			stringEqual := stringFunc(g, mode, "StringEqual%s")
+			if nullable {
+				return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || !` + stringEqual + `(*p, *q)))`
+			}
+			return `!` + stringEqual + `(` + x + `, ` + y + `)`
+		}
 		if nullable {
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {`)
-		} else {
-			g.P(`if `, x+` != `+y, ` {`)
+			return `!` + g.QualifiedGoIdent(equalPackage.Ident("OptionalEqual")) + `(` + x + `, ` + y + `)`
 		}
-		g.P(`return false`)
-		g.P(`}`)
+		return x + ` != ` + y
 
 	case protoreflect.FloatKind, protoreflect.DoubleKind:
-		// TODO: math.IsNaN
+		if nullable && floatTolerance(f) == nil && params.float != floatBits {
+			return `!` + g.QualifiedGoIdent(equalPackage.Ident("OptionalFloatEqual")) + `(` + x + `, ` + y + `)`
+		}
 		if nullable {
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || (`, mathPackage.Ident("IsNaN"), `(float64(*p)) && !`, mathPackage.Ident("IsNaN"), `(float64(*q)) || !`, mathPackage.Ident("IsNaN"), `(float64(*p)) && `, mathPackage.Ident("IsNaN"), `(float64(*q))) || (!`, mathPackage.Ident("IsNaN"), `(float64(*p)) && !`, mathPackage.Ident("IsNaN"), `(float64(*q)) && *p != *q))) {`)
-		} else {
-			g.P(`if (`, mathPackage.Ident("IsNaN"), `(float64(`, x, `)) && !`, mathPackage.Ident("IsNaN"), `(float64(`, y, `)) || !`, mathPackage.Ident("IsNaN"), `(float64(`, x, `)) && `, mathPackage.Ident("IsNaN"), `(float64(`, y, `))) || (!`, mathPackage.Ident("IsNaN"), `(float64(`, x, `)) && !`, mathPackage.Ident("IsNaN"), `(float64(`, y, `)) && `, x, ` != `, y, `) {`)
+			return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || ` + floatNotNear(g, floatTolerance(f), f.Desc.Kind(), `*p`, `*q`, false) + `))`
 		}
-		g.P(`return false`)
-		g.P(`}`)
+		return floatNotNear(g, floatTolerance(f), f.Desc.Kind(), x, y, !repeated && !oneof)
 
 	case protoreflect.BytesKind:
+		if contentType(f) != "" {
+			// Decode only values differing in raw bytes
+			if nullable {
+				return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q) && !` + contentFunc(g, f, "%sEqual", `p`, `q`) + `))`
+			}
+			return `string(` + x + `) != string(` + y + `) && !` + contentFunc(g, f, "%sEqual", x, y)
+		}
 		if nullable {
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q))) {`)
-		} else {
-			g.P(`if string(` + x + `) != string(` + y + `) {`)
+			return `!` + g.QualifiedGoIdent(equalPackage.Ident("OptionalBytesEqual")) + `(` + x + `, ` + y + `)`
 		}
-		g.P(`return false`)
-		g.P(`}`)
+		return `string(` + x + `) != string(` + y + `)`
 
 	case protoreflect.MessageKind, protoreflect.GroupKind:
 		switch f.Message.Location.SourceFile {
 		case "google/protobuf/any.proto":
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {`)
-			g.P(`return false`)
-			g.P(`}`)
+			return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || ` + anyNotEqual(g, f, `p`, `q`) + `))`
 
 		case "google/protobuf/duration.proto", "google/protobuf/timestamp.proto":
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {`)
-			g.P(`return false`)
-			g.P(`}`)
+			return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || ` + timeNotEqual(g, f, `p`, `q`) + `))`
+
+		case "google/protobuf/struct.proto":
+			return `!` + g.QualifiedGoIdent(equalPackage.Ident(f.Message.GoIdent.GoName+"Equal")) + `(` + x + `, ` + y + `, ` + unknownMode(g) + `)`
 
 		case "google/protobuf/empty.proto":
-			g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && q == nil) {`)
-			g.P(`return false`)
-			g.P(`}`)
+			return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && q == nil)`
 
 		case "google/protobuf/wrappers.proto":
-			wrappersBytes := f.Message.Fields[0].Desc.Kind() == protoreflect.BytesKind
-			if wrappersBytes {
-				g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {`)
-			} else {
-				g.P(`if p, q := `, x, `, `, y, `; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {`)
+			switch f.Message.Fields[0].Desc.Kind() {
+			case protoreflect.BytesKind:
+				return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value)))`
+			case protoreflect.FloatKind, protoreflect.DoubleKind:
+				return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || ` + floatNotNear(g, floatTolerance(f), f.Message.Fields[0].Desc.Kind(), `p.Value`, `q.Value`, true) + `))`
+			default:
+				return `p, q := ` + x + `, ` + y + `; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value))`
 			}
-			g.P(`return false`)
-			g.P(`}`)
+
+		case "google/protobuf/field_mask.proto":
+			if params.fieldMask == fieldMaskNormalized {
+				return `!` + g.QualifiedGoIdent(equalPackage.Ident("FieldMaskEqual")) + `(` + x + `, ` + y + `, ` + unknownMode(g) + `)`
+			}
+			fallthrough
 
 		default:
-			isLocalMessage := f.Message != nil && f.Message.Desc != nil && f.Message.Desc.ParentFile() != nil && isLocalPackage[string(f.Message.Desc.ParentFile().Package())]
-			if isLocalMessage {
-				g.P(`if !`, x, `.Equal(`, y, `) {`)
-				g.P(`	return false`)
-				g.P(`}`)
-			} else {
-				g.P(`if equal, ok := interface{}(`, x, `).(interface { Equal(*`, g.QualifiedGoIdent(f.Message.GoIdent), `) bool }); !ok || !equal.Equal(`, y, `) {`)
-				g.P(`	return false`)
-				g.P(`} else if !`, protoPackage.Ident("Equal"), `(`, x, `, `, y, `) {`)
-				g.P(`	return false`)
-				g.P(`}`)
+			if isLocalMessage(f.Message) {
+				return `!` + x + `.` + params.method + `(` + y + `)`
 			}
+			// Use generated Equal when the foreign message has one,
+			// otherwise fallback to proto.Equal
+			return `m, ok := interface{}(` + x + `).(interface { ` + params.method + `(*` + g.QualifiedGoIdent(f.Message.GoIdent) + `) bool }); (ok && !m.` + params.method + `(` + y + `)) || (!ok && !` + g.QualifiedGoIdent(protoPackage.Ident("Equal")) + `(` + x + `, ` + y + `))`
 		}
 
 	// Fallback to proto.Equal
 	default:
-		g.P(`if !`, protoPackage.Ident("Equal"), `(`+x+`, `+y+`) {`)
-		g.P(`return false`)
-		g.P(`}`)
+		return `!` + g.QualifiedGoIdent(protoPackage.Ident("Equal")) + `(` + x + `, ` + y + `)`
+	}
+}
+
+// listEqual returns a call of the runtime function comparing repeated field
+// f of x and y, or "" if the elements need an inline comparison.
+func listEqual(g *protogen.GeneratedFile, f *protogen.Field, x, y string) string {
+	if isComparable(f) {
+		return g.QualifiedGoIdent(equalPackage.Ident("SliceEqual")) + `(` + x + `, ` + y + `)`
+	}
+	if fn := valueEqualFunc(g, f); fn != "" {
+		return g.QualifiedGoIdent(equalPackage.Ident("SliceEqualFunc")) + `(` + x + `, ` + y + `, ` + fn + `)`
+	}
+	return ""
+}
+
+// mapEqual returns a call of the runtime function comparing map field f of x
+// and y, or "" if the values need an inline comparison.
+func mapEqual(g *protogen.GeneratedFile, f *protogen.Field, x, y string) string {
+	value := f.Message.Fields[1]
+	if isComparable(value) {
+		return g.QualifiedGoIdent(equalPackage.Ident("MapEqual")) + `(` + x + `, ` + y + `)`
+	}
+	if fn := valueEqualFunc(g, value); fn != "" {
+		return g.QualifiedGoIdent(equalPackage.Ident("MapEqualFunc")) + `(` + x + `, ` + y + `, ` + fn + `)`
+	}
+	return ""
+}
+
+// isComparable reports whether values of repeated field or map value f are
+// compared with ==.
+func isComparable(f *protogen.Field) bool {
+	switch f.Desc.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind,
+		protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return stringMode(f) == equal.StringMode_STRING_MODE_EXACT
+}
+
+// valueEqualFunc returns a function value reporting whether two values of
+// repeated field or map value f are equal, or "" if there is none.
+func valueEqualFunc(g *protogen.GeneratedFile, f *protogen.Field) string {
+	switch f.Desc.Kind() {
+	case protoreflect.StringKind:
+		return stringFunc(g, stringMode(f), "StringEqual%s")
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if params.float == floatBits || floatTolerance(f) != nil {
+			return ""
+		}
+		return g.QualifiedGoIdent(equalPackage.Ident("FloatEqual")) + `[` + goType(g, f) + `]`
+
+	case protoreflect.BytesKind:
+		switch contentType(f) {
+		case "":
+			return g.QualifiedGoIdent(equalPackage.Ident("BytesEqual"))
+		case "json":
+			return g.QualifiedGoIdent(equalPackage.Ident("JSONEqual"))
+		}
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch {
+		case f.Message.Location.SourceFile == "google/protobuf/struct.proto":
+			return `func(a, b ` + goType(g, f) + `) bool { return ` + g.QualifiedGoIdent(equalPackage.Ident(f.Message.GoIdent.GoName+"Equal")) + `(a, b, ` + unknownMode(g) + `) }`
+		case f.Message.Location.SourceFile == "google/protobuf/field_mask.proto" && params.fieldMask == fieldMaskNormalized:
+			return `func(a, b ` + goType(g, f) + `) bool { return ` + g.QualifiedGoIdent(equalPackage.Ident("FieldMaskEqual")) + `(a, b, ` + unknownMode(g) + `) }`
+		case isLocalMessage(f.Message) && !isWellKnownType(f.Message):
+			return `(*` + g.QualifiedGoIdent(f.Message.GoIdent) + `).` + params.method
+		}
+	}
+	return ""
+}
+
+// fieldPresence reports whether values of field f are nullable (pointers,
+// byte slices or messages) and whether f is a member of a non-synthetic oneof.
+func fieldPresence(f *protogen.Field, proto3 bool, repeated bool) (nullable, oneof bool) {
+	// Some of these lines are stolen from vtprotobuf equal
+	oneof = f.Oneof != nil && !f.Oneof.Desc.IsSynthetic() || (f.Desc.ContainingOneof() != nil && !proto3)
+	nullable = (f.Message != nil || (f.Oneof != nil && f.Oneof.Desc.IsSynthetic()) || (!proto3 && !oneof)) && !repeated
+	return nullable, oneof
+}
+
+// goType returns the Go type of a single value of field f.
+func goType(g *protogen.GeneratedFile, f *protogen.Field) string {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.EnumKind:
+		return g.QualifiedGoIdent(f.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	default:
+		return "*" + g.QualifiedGoIdent(f.Message.GoIdent)
+	}
+}
+
+// isWellKnownType reports whether m is one of the well-known types compared
+// without calling a generated method.
+func isWellKnownType(m *protogen.Message) bool {
+	switch m.Location.SourceFile {
+	case "google/protobuf/any.proto",
+		"google/protobuf/duration.proto", "google/protobuf/timestamp.proto",
+		"google/protobuf/empty.proto",
+		"google/protobuf/struct.proto",
+		"google/protobuf/wrappers.proto":
+		return true
+	case "google/protobuf/field_mask.proto":
+		return params.fieldMask == fieldMaskNormalized
+	}
+	return false
+}
+
+// isLocalMessage reports whether m has methods generated by this plugin invocation.
+func isLocalMessage(m *protogen.Message) bool {
+	return m != nil && m.Desc != nil && m.Desc.ParentFile() != nil && isLocalPackage[string(m.Desc.ParentFile().Package())] && isGenerated(m.Desc)
+}
+
+// anyNotEqual returns an expression reporting whether non-nil Any values p
+// and q of field f differ under the any and type_url parameters and
+// (equal.field).type_url.
+func anyNotEqual(g *protogen.GeneratedFile, f *protogen.Field, p, q string) string {
+	switch {
+	case params.any == anyUnpack && isAnyTypeName(f):
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("AnyNameEqual")) + `(` + p + `, ` + q + `)`
+	case params.any == anyUnpack:
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("AnyEqual")) + `(` + p + `, ` + q + `)`
+	case isAnyTypeName(f):
+		typeName := g.QualifiedGoIdent(equalPackage.Ident("AnyTypeName"))
+		return typeName + `(` + p + `.TypeUrl) != ` + typeName + `(` + q + `.TypeUrl) || string(` + p + `.Value) != string(` + q + `.Value)`
+	}
+	return p + `.TypeUrl != ` + q + `.TypeUrl || string(` + p + `.Value) != string(` + q + `.Value)`
+}
+
+// timeNotEqual returns an expression reporting whether non-nil Timestamp or
+// Duration values p and q of field f differ under the time parameter and
+// (equal.field).time_tolerance.
+func timeNotEqual(g *protogen.GeneratedFile, f *protogen.Field, p, q string) string {
+	args := p + `.Seconds, ` + p + `.Nanos, ` + q + `.Seconds, ` + q + `.Nanos`
+	if tolerance, ok := timeTolerance(f); ok {
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("TimeNear")) + `(` + args + `, ` + strconv.FormatInt(int64(tolerance), 10) + `)`
+	}
+	if params.time == timeNormalized {
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("TimeEqual")) + `(` + args + `)`
+	}
+	return p + `.Seconds != ` + q + `.Seconds || ` + p + `.Nanos != ` + q + `.Nanos`
+}
+
+// floatNotEqual returns an expression reporting whether floats a and b of the
+// given kind differ under the float parameter. Fields without presence need
+// implicitPresence, as proto.Equal considers -0 to be a set value.
+func floatNotEqual(g *protogen.GeneratedFile, kind protoreflect.Kind, a, b string, implicitPresence bool) string {
+	if params.float == floatBits {
+		bits := g.QualifiedGoIdent(mathPackage.Ident("Float64bits"))
+		if kind == protoreflect.FloatKind {
+			bits = g.QualifiedGoIdent(mathPackage.Ident("Float32bits"))
+		}
+		return bits + `(` + a + `) != ` + bits + `(` + b + `)`
+	}
+
+	if params.float == floatProto && implicitPresence {
+		return `!` + g.QualifiedGoIdent(equalPackage.Ident("FloatEqualSignedZero")) + `(` + a + `, ` + b + `)`
+	}
+	return `!` + g.QualifiedGoIdent(equalPackage.Ident("FloatEqual")) + `(` + a + `, ` + b + `)`
+}
+
+// floatNotNear is floatNotEqual for fields with (equal.field).tolerance,
+// values differing within the tolerance are equal.
+func floatNotNear(g *protogen.GeneratedFile, tolerance *equal.Tolerance, kind protoreflect.Kind, a, b string, implicitPresence bool) string {
+	if tolerance == nil {
+		return floatNotEqual(g, kind, a, b, implicitPresence)
+	}
+	return `(` + floatNotEqual(g, kind, a, b, implicitPresence) + `) && !` + floatNear(g, tolerance, kind, a, b)
+}
+
+// floatNear returns an expression reporting whether floats a and b of the
+// given kind are within tolerance.
+func floatNear(g *protogen.GeneratedFile, tolerance *equal.Tolerance, kind protoreflect.Kind, a, b string) string {
+	args := strconv.FormatFloat(tolerance.GetAbsolute(), 'g', -1, 64) + `, ` +
+		strconv.FormatFloat(tolerance.GetRelative(), 'g', -1, 64) + `, ` +
+		strconv.FormatUint(tolerance.GetUlps(), 10)
+	if kind == protoreflect.FloatKind {
+		return g.QualifiedGoIdent(equalPackage.Ident("Float32Near")) + `(` + a + `, ` + b + `, ` + args + `)`
 	}
+	return g.QualifiedGoIdent(equalPackage.Ident("Float64Near")) + `(` + a + `, ` + b + `, ` + args + `)`
 }
@@ -0,0 +1,102 @@
+package equal
+
+import (
+	"bytes"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// AnyResolver resolves the message types of google.protobuf.Any values
+// compared by code generated with any=unpack. It defaults to
+// protoregistry.GlobalTypes and may be replaced during initialization.
+var AnyResolver interface {
+	FindMessageByURL(url string) (protoreflect.MessageType, error)
+} = protoregistry.GlobalTypes
+
+// AnyEqual reports whether non-nil x and y hold equal messages. Values with
+// equal type URLs and bytes are equal without unpacking. Otherwise both are
+// unpacked using AnyResolver and compared by Messages. Values of unknown
+// types or failing to unmarshal are compared by their bytes.
+func AnyEqual(x, y *anypb.Any) bool {
+	return x.TypeUrl == y.TypeUrl && anyValueEqual(x, y)
+}
+
+// AnyNameEqual is AnyEqual comparing only the message names of type URLs as
+// returned by AnyTypeName.
+func AnyNameEqual(x, y *anypb.Any) bool {
+	return AnyTypeName(x.TypeUrl) == AnyTypeName(y.TypeUrl) && anyValueEqual(x, y)
+}
+
+func anyValueEqual(x, y *anypb.Any) bool {
+	if string(x.Value) == string(y.Value) {
+		return true
+	}
+	mx, my, ok := unpackAny(x, y)
+	return ok && Messages(mx, my)
+}
+
+// CompareAny orders non-nil x and y consistently with AnyEqual, by type URL
+// and then by the unpacked messages as by CompareMessages. Values of unknown
+// types are ordered by their bytes.
+func CompareAny(x, y *anypb.Any) int {
+	if c := CompareOrdered(x.TypeUrl, y.TypeUrl); c != 0 {
+		return c
+	}
+	return compareAnyValue(x, y)
+}
+
+// CompareAnyName orders non-nil x and y consistently with AnyNameEqual.
+func CompareAnyName(x, y *anypb.Any) int {
+	if c := CompareOrdered(AnyTypeName(x.TypeUrl), AnyTypeName(y.TypeUrl)); c != 0 {
+		return c
+	}
+	return compareAnyValue(x, y)
+}
+
+func compareAnyValue(x, y *anypb.Any) int {
+	if string(x.Value) == string(y.Value) {
+		return 0
+	}
+	mx, my, ok := unpackAny(x, y)
+	if !ok {
+		return bytes.Compare(x.Value, y.Value)
+	}
+	if Messages(mx, my) {
+		return 0
+	}
+	if c := CompareMessages(mx, my); c != 0 {
+		return c
+	}
+	return bytes.Compare(x.Value, y.Value)
+}
+
+// AnyTypeName returns the fully-qualified message name of type URL url, the
+// part following its last '/'.
+func AnyTypeName(url string) string {
+	return url[strings.LastIndexByte(url, '/')+1:]
+}
+
+// unpackAny unmarshals x and y having the same message type. It reports false
+// when the type is unknown or either value is malformed.
+func unpackAny(x, y *anypb.Any) (proto.Message, proto.Message, bool) {
+	return unmarshalPair(x.TypeUrl, x.Value, y.Value)
+}
+
+// unmarshalPair unmarshals a and b as messages of the type resolved from url
+// by AnyResolver. It reports false when the type is unknown or either value
+// is malformed.
+func unmarshalPair(url string, a, b []byte) (proto.Message, proto.Message, bool) {
+	mt, err := AnyResolver.FindMessageByURL(url)
+	if err != nil {
+		return nil, nil, false
+	}
+	mx, my := mt.New().Interface(), mt.New().Interface()
+	if proto.Unmarshal(a, mx) != nil || proto.Unmarshal(b, my) != nil {
+		return nil, nil, false
+	}
+	return mx, my, true
+}
@@ -0,0 +1,86 @@
+package equal
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	// Register google.protobuf.Struct in protoregistry.GlobalTypes
+	_ "google.golang.org/protobuf/types/known/structpb"
+)
+
+// structAny returns an Any holding a google.protobuf.Struct with string
+// fields encoded in the given order.
+func structAny(fields ...string) *anypb.Any {
+	var b []byte
+	for _, f := range fields {
+		var value, entry []byte
+		value = protowire.AppendTag(value, 3, protowire.BytesType)
+		value = protowire.AppendString(value, f)
+		entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, f)
+		entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+		entry = protowire.AppendBytes(entry, value)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+	return &anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Struct", Value: b}
+}
+
+func TestAnyEqual(t *testing.T) {
+	tests := []struct {
+		x, y *anypb.Any
+		eq   bool
+	}{
+		{x: structAny("a", "b"), y: structAny("a", "b"), eq: true},
+		{x: structAny("a", "b"), y: structAny("b", "a"), eq: true},
+		{x: structAny("a", "b"), y: structAny("a", "c")},
+		{x: structAny("a"), y: &anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Value", Value: structAny("a").Value}},
+		{x: structAny("a"), y: &anypb.Any{TypeUrl: structAny("a").TypeUrl, Value: []byte{0xff}}},
+		{x: &anypb.Any{TypeUrl: "example.com/Unknown", Value: []byte{1}}, y: &anypb.Any{TypeUrl: "example.com/Unknown", Value: []byte{1}}, eq: true},
+		{x: &anypb.Any{TypeUrl: "example.com/Unknown", Value: []byte{1}}, y: &anypb.Any{TypeUrl: "example.com/Unknown", Value: []byte{2}}},
+	}
+	for _, tt := range tests {
+		if eq := AnyEqual(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("AnyEqual(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+		if c := CompareAny(tt.x, tt.y); (c == 0) != tt.eq || c != -CompareAny(tt.y, tt.x) {
+			t.Errorf("CompareAny(%v, %v) = %v, CompareAny(y, x) = %v, want equal %v", tt.x, tt.y, c, CompareAny(tt.y, tt.x), tt.eq)
+		}
+	}
+}
+
+func TestAnyTypeName(t *testing.T) {
+	tests := []struct {
+		x, y *anypb.Any
+		eq   bool
+	}{
+		{x: structAny("a", "b"), y: &anypb.Any{TypeUrl: "example.com/types/google.protobuf.Struct", Value: structAny("b", "a").Value}, eq: true},
+		{x: structAny("a"), y: &anypb.Any{TypeUrl: "google.protobuf.Struct", Value: structAny("a").Value}, eq: true},
+		{x: structAny("a"), y: &anypb.Any{TypeUrl: "example.com/google.protobuf.Value", Value: structAny("a").Value}},
+	}
+	for _, tt := range tests {
+		if AnyEqual(tt.x, tt.y) {
+			t.Errorf("AnyEqual(%v, %v) = true, want false", tt.x, tt.y)
+		}
+		if eq := AnyNameEqual(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("AnyNameEqual(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+		if c := CompareAnyName(tt.x, tt.y); (c == 0) != tt.eq || c != -CompareAnyName(tt.y, tt.x) {
+			t.Errorf("CompareAnyName(%v, %v) = %v, want equal %v", tt.x, tt.y, c, tt.eq)
+		}
+	}
+}
+
+func TestAnyResolver(t *testing.T) {
+	resolver := AnyResolver
+	defer func() { AnyResolver = resolver }()
+
+	// Types unknown to the resolver are compared by their bytes
+	AnyResolver = new(protoregistry.Types)
+	if AnyEqual(structAny("a", "b"), structAny("b", "a")) {
+		t.Errorf("AnyEqual() with empty resolver = true, want false")
+	}
+}
@@ -0,0 +1,254 @@
+package equal
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ordered is the set of Go types of ordered protobuf scalars.
+type ordered interface {
+	~int | ~int32 | ~int64 | ~uint32 | ~uint64 | ~string
+}
+
+// CompareOrdered returns -1, 0 or +1 depending on whether a is less than,
+// equal to or greater than b.
+func CompareOrdered[T ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// CompareBool orders false before true.
+func CompareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+// CompareFloat64 orders NaNs before all other values and considers all NaNs
+// equal and -0 equal to +0, like the float=equal policy.
+func CompareFloat64(a, b float64) int {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return CompareBool(!math.IsNaN(a), !math.IsNaN(b))
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// CompareFloat64SignedZero is like CompareFloat64 but orders -0 before +0,
+// like the float=proto policy for fields without presence.
+func CompareFloat64SignedZero(a, b float64) int {
+	if c := CompareFloat64(a, b); c != 0 || a != 0 {
+		return c
+	}
+	return CompareBool(!math.Signbit(a), !math.Signbit(b))
+}
+
+// CompareFloat64Bits orders values like CompareFloat64 and values it
+// considers equal by their bit representation, like the float=bits policy.
+func CompareFloat64Bits(a, b float64) int {
+	if c := CompareFloat64(a, b); c != 0 {
+		return c
+	}
+	return CompareOrdered(math.Float64bits(a), math.Float64bits(b))
+}
+
+// CompareFloat32Bits is CompareFloat64Bits for float32 values.
+func CompareFloat32Bits(a, b float32) int {
+	if c := CompareFloat64(float64(a), float64(b)); c != 0 {
+		return c
+	}
+	return CompareOrdered(math.Float32bits(a), math.Float32bits(b))
+}
+
+// SortedKeys returns the sorted union of the keys of maps x and y.
+func SortedKeys[K ordered, V any](x, y map[K]V) []K {
+	keys := make([]K, 0, len(x)+len(y))
+	for k := range x {
+		keys = append(keys, k)
+	}
+	for k := range y {
+		if _, ok := x[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// SortedBoolKeys is SortedKeys for maps with bool keys.
+func SortedBoolKeys[V any](x, y map[bool]V) []bool {
+	keys := make([]bool, 0, 2)
+	for _, k := range []bool{false, true} {
+		_, okx := x[k]
+		_, oky := y[k]
+		if okx || oky {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// CompareMessages orders messages without a generated Compare method.
+// Messages equal according to proto.Equal compare as equal, others are
+// ordered by their deterministic wire encoding.
+//
+// The order is consistent with proto.Equal but it is not a total order when
+// equal messages encode differently, e.g. with -0 and +0 floats or unknown
+// fields in a different order: the ordering of a third message between them
+// need not be transitive. Generated Compare methods use it only for foreign
+// messages without a Compare method.
+func CompareMessages(x, y proto.Message) int {
+	vx, vy := x.ProtoReflect().IsValid(), y.ProtoReflect().IsValid()
+	if !vx || !vy {
+		return CompareBool(vx, vy)
+	}
+	if proto.Equal(x, y) {
+		return 0
+	}
+	opts := proto.MarshalOptions{Deterministic: true}
+	bx, _ := opts.Marshal(x)
+	by, _ := opts.Marshal(y)
+	return bytes.Compare(bx, by)
+}
+
+// CompareUnknownFields orders unknown fields x and y consistently with
+// UnknownFields by comparing them with fields stably sorted by field number.
+func CompareUnknownFields(x, y protoreflect.RawFields) int {
+	if UnknownFields(x, y) {
+		return 0
+	}
+	return bytes.Compare(sortUnknownFields(x), sortUnknownFields(y))
+}
+
+// sortUnknownFields stably sorts the raw unknown fields by field number.
+// Malformed fields are returned unchanged.
+func sortUnknownFields(b protoreflect.RawFields) []byte {
+	type field struct {
+		num protowire.Number
+		raw []byte
+	}
+	var fields []field
+	for rest := b; len(rest) > 0; {
+		num, _, n := protowire.ConsumeField(rest)
+		if n < 0 {
+			return b
+		}
+		fields = append(fields, field{num, rest[:n]})
+		rest = rest[n:]
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].num < fields[j].num })
+
+	out := make([]byte, 0, len(b))
+	for _, f := range fields {
+		out = append(out, f.raw...)
+	}
+	return out
+}
+
+// CompareExtensions orders the populated extension fields of x and y
+// consistently with Extensions. Extensions are walked by field number; a
+// message having an extension the other lacks sorts first. Values are ordered
+// like the generated Compare methods order fields, with floats ordered in
+// mode.
+//
+// Message values are ordered by compare, which generated code uses to call
+// the generated Compare method of message types it knows about. If compare is
+// nil message values are ordered by CompareMessages.
+func CompareExtensions(x, y protoreflect.Message, mode FloatMode, compare func(x, y protoreflect.Message) int) int {
+	if compare == nil {
+		compare = compareMessage
+	}
+
+	fx, fy := extensionFields(x), extensionFields(y)
+	for i := 0; i < len(fx) && i < len(fy); i++ {
+		if fx[i].Number() != fy[i].Number() {
+			return CompareOrdered(fx[i].Number(), fy[i].Number())
+		}
+		if c := compareExtension(fx[i], x.Get(fx[i]), y.Get(fy[i]), mode, compare); c != 0 {
+			return c
+		}
+	}
+	return CompareOrdered(len(fy), len(fx))
+}
+
+// extensionFields returns the populated extension fields of m sorted by number.
+func extensionFields(m protoreflect.Message) []protoreflect.FieldDescriptor {
+	var fds []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			fds = append(fds, fd)
+		}
+		return true
+	})
+	sort.Slice(fds, func(i, j int) bool { return fds[i].Number() < fds[j].Number() })
+	return fds
+}
+
+func compareExtension(fd protoreflect.FieldDescriptor, x, y protoreflect.Value, mode FloatMode, compare func(x, y protoreflect.Message) int) int {
+	if !fd.IsList() {
+		return compareValue(fd, x, y, mode, compare)
+	}
+
+	lx, ly := x.List(), y.List()
+	for i := 0; i < lx.Len() && i < ly.Len(); i++ {
+		if c := compareValue(fd, lx.Get(i), ly.Get(i), mode, compare); c != 0 {
+			return c
+		}
+	}
+	return CompareOrdered(lx.Len(), ly.Len())
+}
+
+func compareValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value, mode FloatMode, compare func(x, y protoreflect.Message) int) int {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return CompareBool(x.Bool(), y.Bool())
+	case protoreflect.EnumKind:
+		return CompareOrdered(x.Enum(), y.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return CompareOrdered(x.Int(), y.Int())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return CompareOrdered(x.Uint(), y.Uint())
+	case protoreflect.FloatKind:
+		if mode == FloatBits {
+			return CompareFloat32Bits(float32(x.Float()), float32(y.Float()))
+		}
+		return CompareFloat64(x.Float(), y.Float())
+	case protoreflect.DoubleKind:
+		if mode == FloatBits {
+			return CompareFloat64Bits(x.Float(), y.Float())
+		}
+		return CompareFloat64(x.Float(), y.Float())
+	case protoreflect.StringKind:
+		return CompareOrdered(x.String(), y.String())
+	case protoreflect.BytesKind:
+		return bytes.Compare(x.Bytes(), y.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return compare(x.Message(), y.Message())
+	}
+	return 0
+}
+
+func compareMessage(x, y protoreflect.Message) int {
+	return CompareMessages(x.Interface(), y.Interface())
+}
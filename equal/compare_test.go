@@ -0,0 +1,33 @@
+package equal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompareFloat64(t *testing.T) {
+	nan := math.NaN()
+	negZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		a, b               float64
+		want, signed, bits int
+	}{
+		{a: 1, b: 2, want: -1, signed: -1, bits: -1},
+		{a: nan, b: math.Inf(-1), want: -1, signed: -1, bits: -1},
+		{a: nan, b: nan, want: 0, signed: 0, bits: 0},
+		{a: negZero, b: 0, want: 0, signed: -1, bits: 1},
+		{a: 0, b: 0, want: 0, signed: 0, bits: 0},
+	}
+	for _, tt := range tests {
+		if c := CompareFloat64(tt.a, tt.b); c != tt.want {
+			t.Errorf("CompareFloat64(%v, %v) = %v, want %v", tt.a, tt.b, c, tt.want)
+		}
+		if c := CompareFloat64SignedZero(tt.a, tt.b); c != tt.signed {
+			t.Errorf("CompareFloat64SignedZero(%v, %v) = %v, want %v", tt.a, tt.b, c, tt.signed)
+		}
+		if c := CompareFloat64Bits(tt.a, tt.b); c != tt.bits {
+			t.Errorf("CompareFloat64Bits(%v, %v) = %v, want %v", tt.a, tt.b, c, tt.bits)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package equal
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sort"
+)
+
+// JSONEqual reports whether a and b hold equal JSON documents, ignoring
+// whitespace and the order of object members. Numbers are compared by their
+// exact decimal values. Equal bytes are equal without decoding, and values
+// failing to decode are compared by their bytes.
+func JSONEqual(a, b []byte) bool {
+	return string(a) == string(b) || CompareJSON(a, b) == 0
+}
+
+// CompareJSON orders a and b consistently with JSONEqual. Documents are
+// ordered by kind (null, booleans, numbers, strings, arrays, objects) and
+// then by value; they precede values failing to decode, which are ordered by
+// their bytes.
+func CompareJSON(a, b []byte) int {
+	if string(a) == string(b) {
+		return 0
+	}
+	va, oka := decodeJSON(a)
+	vb, okb := decodeJSON(b)
+	if !oka || !okb {
+		if c := CompareBool(!oka, !okb); c != 0 {
+			return c
+		}
+		return bytes.Compare(a, b)
+	}
+	return compareJSONValue(va, vb)
+}
+
+// MessageBytesEqual reports whether a and b hold equal serialized messages
+// named name. Equal bytes are equal without unmarshalling. Otherwise both are
+// unmarshalled as messages resolved by AnyResolver and compared by Messages.
+// Values of unknown types or failing to unmarshal are compared by their
+// bytes.
+func MessageBytesEqual(a, b []byte, name string) bool {
+	if string(a) == string(b) {
+		return true
+	}
+	mx, my, ok := unmarshalPair(name, a, b)
+	return ok && Messages(mx, my)
+}
+
+// CompareMessageBytes orders a and b consistently with MessageBytesEqual, by
+// the unmarshalled messages as by CompareMessages. Values of unknown types or
+// failing to unmarshal are ordered by their bytes.
+func CompareMessageBytes(a, b []byte, name string) int {
+	if string(a) == string(b) {
+		return 0
+	}
+	mx, my, ok := unmarshalPair(name, a, b)
+	if !ok {
+		return bytes.Compare(a, b)
+	}
+	if Messages(mx, my) {
+		return 0
+	}
+	if c := CompareMessages(mx, my); c != 0 {
+		return c
+	}
+	return bytes.Compare(a, b)
+}
+
+// decodeJSON decodes a single JSON document, keeping numbers as json.Number.
+func decodeJSON(b []byte) (interface{}, bool) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if d.Decode(&v) != nil {
+		return nil, false
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, false
+	}
+	return v, true
+}
+
+// jsonKind returns the rank of the kind of decoded JSON value v.
+func jsonKind(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case json.Number:
+		return 2
+	case string:
+		return 3
+	case []interface{}:
+		return 4
+	default:
+		return 5
+	}
+}
+
+func compareJSONValue(a, b interface{}) int {
+	if c := CompareOrdered(jsonKind(a), jsonKind(b)); c != 0 {
+		return c
+	}
+	switch a := a.(type) {
+	case bool:
+		return CompareBool(a, b.(bool))
+	case json.Number:
+		return compareJSONNumber(a, b.(json.Number))
+	case string:
+		return CompareOrdered(a, b.(string))
+	case []interface{}:
+		b := b.([]interface{})
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if c := compareJSONValue(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return CompareOrdered(len(a), len(b))
+	case map[string]interface{}:
+		b := b.(map[string]interface{})
+		ka, kb := sortedJSONKeys(a), sortedJSONKeys(b)
+		for i := 0; i < len(ka) && i < len(kb); i++ {
+			if c := CompareOrdered(ka[i], kb[i]); c != 0 {
+				return c
+			}
+			if c := compareJSONValue(a[ka[i]], b[kb[i]]); c != 0 {
+				return c
+			}
+		}
+		return CompareOrdered(len(ka), len(kb))
+	}
+	return 0
+}
+
+func compareJSONNumber(a, b json.Number) int {
+	if a == b {
+		return 0
+	}
+	ra, oka := new(big.Rat).SetString(string(a))
+	rb, okb := new(big.Rat).SetString(string(b))
+	if !oka || !okb {
+		return CompareOrdered(a, b)
+	}
+	return ra.Cmp(rb)
+}
+
+func sortedJSONKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,66 @@
+package equal
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestJSONEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		eq   bool
+	}{
+		{a: `{"a":1,"b":2}`, b: ` { "b": 2, "a": 1 } `, eq: true},
+		{a: `1`, b: `1.0`, eq: true},
+		{a: `100`, b: `1e2`, eq: true},
+		{a: `9007199254740993`, b: `9007199254740992`},
+		{a: `[1,2]`, b: `[2,1]`},
+		{a: `[1]`, b: `[1,1]`},
+		{a: `{"a":1}`, b: `{"a":1,"b":null}`},
+		{a: `null`, b: `false`},
+		{a: `""`, b: `[]`},
+		{a: `"\u00e9"`, b: "\"\u00e9\"", eq: true},
+		{a: `{`, b: `{`, eq: true},
+		{a: `{`, b: ` {`},
+		{a: `1 2`, b: `1`},
+		{a: ``, b: `null`},
+	}
+	for _, tt := range tests {
+		if eq := JSONEqual([]byte(tt.a), []byte(tt.b)); eq != tt.eq {
+			t.Errorf("JSONEqual(%q, %q) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+		if c := CompareJSON([]byte(tt.a), []byte(tt.b)); (c == 0) != tt.eq || c != -CompareJSON([]byte(tt.b), []byte(tt.a)) {
+			t.Errorf("CompareJSON(%q, %q) = %v, CompareJSON(b, a) = %v, want equal %v", tt.a, tt.b, c, CompareJSON([]byte(tt.b), []byte(tt.a)), tt.eq)
+		}
+	}
+}
+
+func TestMessageBytesEqual(t *testing.T) {
+	ab := structAny("a", "b").Value
+	ba := structAny("b", "a").Value
+	ac := structAny("a", "c").Value
+	empty, _ := proto.Marshal(&structpb.Struct{})
+
+	tests := []struct {
+		a, b []byte
+		name string
+		eq   bool
+	}{
+		{a: ab, b: ba, name: "google.protobuf.Struct", eq: true},
+		{a: ab, b: ac, name: "google.protobuf.Struct"},
+		{a: nil, b: empty, name: "google.protobuf.Struct", eq: true},
+		{a: ab, b: []byte{0xff}, name: "google.protobuf.Struct"},
+		{a: ab, b: ba, name: "example.Unknown"},
+		{a: ab, b: ab, name: "example.Unknown", eq: true},
+	}
+	for _, tt := range tests {
+		if eq := MessageBytesEqual(tt.a, tt.b, tt.name); eq != tt.eq {
+			t.Errorf("MessageBytesEqual(%x, %x, %s) = %v, want %v", tt.a, tt.b, tt.name, eq, tt.eq)
+		}
+		if c := CompareMessageBytes(tt.a, tt.b, tt.name); (c == 0) != tt.eq || c != -CompareMessageBytes(tt.b, tt.a, tt.name) {
+			t.Errorf("CompareMessageBytes(%x, %x, %s) = %v, want equal %v", tt.a, tt.b, tt.name, c, tt.eq)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package equal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Difference is a single difference reported by a generated Diff method.
+type Difference struct {
+	// Path to the differing value relative to the compared message, e.g.
+	// "a.b[1].c" or `m["key"]`. Empty when one of the messages is nil.
+	Path string
+
+	// X and Y are the differing values. A value is nil when it is not
+	// present, e.g. for a map key missing on one side.
+	X, Y interface{}
+}
+
+// String returns a human readable representation of the difference.
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "."
+	}
+	return path + ": " + formatValue(d.X) + " != " + formatValue(d.Y)
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "<nil>"
+	case proto.Message:
+		if !v.ProtoReflect().IsValid() {
+			return "<nil>"
+		}
+		return "{" + prototext.MarshalOptions{}.Format(v) + "}"
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return strconv.Quote(string(v))
+	}
+
+	// Optional scalars are pointers
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "<nil>"
+		}
+		return formatValue(rv.Elem().Interface())
+	}
+	return fmt.Sprint(v)
+}
+
+// Index returns the path of element i of the list field name.
+func Index(name string, i int) string {
+	return name + "[" + strconv.Itoa(i) + "]"
+}
+
+// Key returns the path of the map field name entry with key k.
+func Key(name string, k interface{}) string {
+	if s, ok := k.(string); ok {
+		return name + "[" + strconv.Quote(s) + "]"
+	}
+	return name + "[" + fmt.Sprint(k) + "]"
+}
+
+// AppendNested appends differences found in a message at path to d.
+func AppendNested(d []Difference, path string, nested []Difference) []Difference {
+	for _, n := range nested {
+		switch {
+		case n.Path == "":
+			n.Path = path
+		case strings.HasPrefix(n.Path, "["):
+			n.Path = path + n.Path
+		default:
+			n.Path = path + "." + n.Path
+		}
+		d = append(d, n)
+	}
+	return d
+}
+
+// DiffExtensions returns the differences between extension fields of x and y,
+// using the same comparison as Extensions. Extensions are reported in field
+// number order with paths in the text format syntax, e.g. "[pkg.ext]".
+func DiffExtensions(x, y protoreflect.Message, mode FloatMode, equal func(x, y protoreflect.Message) bool) []Difference {
+	if equal == nil {
+		equal = equalMessage
+	}
+
+	var fds []protoreflect.FieldDescriptor
+	seen := make(map[protoreflect.FullName]bool)
+	collect := func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() && !seen[fd.FullName()] {
+			seen[fd.FullName()] = true
+			fds = append(fds, fd)
+		}
+		return true
+	}
+	x.Range(collect)
+	y.Range(collect)
+	sort.Slice(fds, func(i, j int) bool {
+		return fds[i].Number() < fds[j].Number()
+	})
+
+	var d []Difference
+	for _, fd := range fds {
+		hx, hy := x.Has(fd), y.Has(fd)
+		if hx && hy && equalExtension(fd, x.Get(fd), y.Get(fd), mode, equal) {
+			continue
+		}
+		diff := Difference{Path: "[" + string(fd.FullName()) + "]"}
+		if hx {
+			diff.X = extensionValue(x, fd)
+		}
+		if hy {
+			diff.Y = extensionValue(y, fd)
+		}
+		d = append(d, diff)
+	}
+	return d
+}
+
+func extensionValue(m protoreflect.Message, fd protoreflect.FieldDescriptor) interface{} {
+	xt, ok := fd.(protoreflect.ExtensionTypeDescriptor)
+	if !ok {
+		return m.Get(fd).Interface()
+	}
+	return xt.Type().InterfaceOf(m.Get(fd))
+}
@@ -0,0 +1,116 @@
+package equal
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FloatMode selects how float and double extension values are compared,
+// matching the float parameter of the generated code.
+type FloatMode int
+
+const (
+	// FloatNumeric compares floats numerically with NaNs equal to each other
+	// and -0 equal to +0, as float=equal and float=proto do for fields with
+	// presence.
+	FloatNumeric FloatMode = iota
+
+	// FloatBits compares floats by their bit representation, as float=bits.
+	FloatBits
+)
+
+// Extensions reports whether x and y have the same populated extension fields
+// with equal values. Floats are compared in mode.
+//
+// Message values are compared with equal, which generated code uses to call
+// the generated Equal method of message types it knows about. If equal is nil
+// message values are compared with proto.Equal.
+func Extensions(x, y protoreflect.Message, mode FloatMode, equal func(x, y protoreflect.Message) bool) bool {
+	if equal == nil {
+		equal = equalMessage
+	}
+
+	n := 0
+	y.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() {
+			n++
+		}
+		return true
+	})
+
+	eq := true
+	x.Range(func(fd protoreflect.FieldDescriptor, vx protoreflect.Value) bool {
+		if !fd.IsExtension() {
+			return true
+		}
+		n--
+		eq = y.Has(fd) && equalExtension(fd, vx, y.Get(fd), mode, equal)
+		return eq
+	})
+	return eq && n == 0
+}
+
+func equalExtension(fd protoreflect.FieldDescriptor, x, y protoreflect.Value, mode FloatMode, equal func(x, y protoreflect.Message) bool) bool {
+	if !fd.IsList() {
+		return equalValue(fd, x, y, mode, equal)
+	}
+
+	lx, ly := x.List(), y.List()
+	if lx.Len() != ly.Len() {
+		return false
+	}
+	for i := 0; i < lx.Len(); i++ {
+		if !equalValue(fd, lx.Get(i), ly.Get(i), mode, equal) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value, mode FloatMode, equal func(x, y protoreflect.Message) bool) bool {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return x.Bool() == y.Bool()
+	case protoreflect.EnumKind:
+		return x.Enum() == y.Enum()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return x.Int() == y.Int()
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return x.Uint() == y.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		fx, fy := x.Float(), y.Float()
+		if mode == FloatBits {
+			return floatBits(fd, fx) == floatBits(fd, fy)
+		}
+		if math.IsNaN(fx) || math.IsNaN(fy) {
+			return math.IsNaN(fx) && math.IsNaN(fy)
+		}
+		return fx == fy
+	case protoreflect.StringKind:
+		return x.String() == y.String()
+	case protoreflect.BytesKind:
+		return string(x.Bytes()) == string(y.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return equal(x.Message(), y.Message())
+	default:
+		return x.Interface() == y.Interface()
+	}
+}
+
+// floatBits returns the bit representation of float or double value v of a
+// field fd.
+func floatBits(fd protoreflect.FieldDescriptor, v float64) uint64 {
+	if fd.Kind() == protoreflect.FloatKind {
+		return uint64(math.Float32bits(float32(v)))
+	}
+	return math.Float64bits(v)
+}
+
+func equalMessage(x, y protoreflect.Message) bool {
+	return proto.Equal(x.Interface(), y.Interface())
+}
@@ -0,0 +1,95 @@
+package equal
+
+import (
+	"hash/maphash"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldMaskEqual reports whether FieldMask messages x and y select the same
+// fields, comparing their paths normalized as by FieldMask.Normalize: sorted,
+// without duplicates and without paths covered by other paths, so ["a", "b"]
+// equals ["b", "a", "a.c"]. Masks with equal paths in the same order are
+// compared without allocating. Unknown fields are compared in mode.
+func FieldMaskEqual(x, y *fieldmaskpb.FieldMask, mode UnknownMode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return false
+	}
+	if !unknownEqual(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode) {
+		return false
+	}
+	if stringsEqual(x.Paths, y.Paths) {
+		return true
+	}
+	return stringsEqual(normalizedPaths(x), normalizedPaths(y))
+}
+
+// HashFieldMask writes the normalized paths of x to h consistently with
+// FieldMaskEqual.
+func HashFieldMask(h *maphash.Hash, x *fieldmaskpb.FieldMask, mode UnknownMode) {
+	HashBool(h, x != nil)
+	if x == nil {
+		return
+	}
+	paths := normalizedPaths(x)
+	HashUint64(h, uint64(len(paths)))
+	for _, p := range paths {
+		HashString(h, p)
+	}
+	hashUnknown(h, x.ProtoReflect().GetUnknown(), mode)
+}
+
+// CompareFieldMask orders FieldMask messages consistently with
+// FieldMaskEqual, lexicographically by their normalized paths.
+func CompareFieldMask(x, y *fieldmaskpb.FieldMask, mode UnknownMode) int {
+	if x == y {
+		return 0
+	}
+	if x == nil || y == nil {
+		return CompareBool(x != nil, y != nil)
+	}
+	px, py := x.Paths, y.Paths
+	if !stringsEqual(px, py) {
+		px, py = normalizedPaths(x), normalizedPaths(y)
+	}
+	for i := 0; i < len(px) && i < len(py); i++ {
+		if c := CompareOrdered(px[i], py[i]); c != 0 {
+			return c
+		}
+	}
+	if c := CompareOrdered(len(px), len(py)); c != 0 {
+		return c
+	}
+	return compareUnknown(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// normalizedPaths returns the paths of m normalized without modifying m.
+func normalizedPaths(m *fieldmaskpb.FieldMask) []string {
+	c := &fieldmaskpb.FieldMask{Paths: append([]string(nil), m.Paths...)}
+	c.Normalize()
+	return c.Paths
+}
+
+func stringsEqual(x, y []string) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// AppendNestedPaths appends the paths of nested, the fields changed in a
+// message at path, to paths as dotted paths below path.
+func AppendNestedPaths(paths []string, path string, nested *fieldmaskpb.FieldMask) []string {
+	for _, p := range nested.GetPaths() {
+		paths = append(paths, path+"."+p)
+	}
+	return paths
+}
@@ -0,0 +1,72 @@
+package equal
+
+import (
+	"hash/maphash"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestFieldMaskEqual(t *testing.T) {
+	mask := func(paths ...string) *fieldmaskpb.FieldMask {
+		return &fieldmaskpb.FieldMask{Paths: paths}
+	}
+
+	withUnknown := func(m *fieldmaskpb.FieldMask, b ...byte) *fieldmaskpb.FieldMask {
+		m.ProtoReflect().SetUnknown(b)
+		return m
+	}
+
+	tests := []struct {
+		x, y *fieldmaskpb.FieldMask
+		mode UnknownMode
+		eq   bool
+	}{
+		{x: mask("a", "b"), y: mask("a", "b"), eq: true},
+		{x: mask("a", "b"), y: mask("b", "a"), eq: true},
+		{x: mask("a"), y: mask("a", "a.b"), eq: true},
+		{x: mask("a", "a"), y: mask("a"), eq: true},
+		{x: mask(), y: mask(), eq: true},
+		{x: mask("a"), y: mask("a.b")},
+		{x: mask("a"), y: mask("ab")},
+		{x: mask("a", "b"), y: mask("a")},
+		{x: mask(), y: nil},
+		{x: withUnknown(mask("a"), 0x10, 1, 0x18, 2), y: withUnknown(mask("a"), 0x18, 2, 0x10, 1), eq: true},
+		{x: withUnknown(mask("a"), 0x10, 1, 0x18, 2), y: withUnknown(mask("a"), 0x18, 2, 0x10, 1), mode: UnknownRaw},
+		{x: withUnknown(mask("a"), 0x10, 1), y: mask("a")},
+		{x: withUnknown(mask("a"), 0x10, 1), y: mask("a"), mode: UnknownIgnore, eq: true},
+	}
+
+	seed := maphash.MakeSeed()
+	hash := func(m *fieldmaskpb.FieldMask, mode UnknownMode) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashFieldMask(&h, m, mode)
+		return h.Sum64()
+	}
+
+	for _, tt := range tests {
+		if eq := FieldMaskEqual(tt.x, tt.y, tt.mode); eq != tt.eq {
+			t.Errorf("FieldMaskEqual(%v, %v, %v) = %v, want %v", tt.x, tt.y, tt.mode, eq, tt.eq)
+		}
+		if tt.eq && hash(tt.x, tt.mode) != hash(tt.y, tt.mode) {
+			t.Errorf("HashFieldMask(%v) != HashFieldMask(%v) for equal masks", tt.x, tt.y)
+		}
+		if c := CompareFieldMask(tt.x, tt.y, tt.mode); (c == 0) != tt.eq || c != -CompareFieldMask(tt.y, tt.x, tt.mode) {
+			t.Errorf("CompareFieldMask(%v, %v, %v) = %v, CompareFieldMask(y, x) = %v, want equal %v", tt.x, tt.y, tt.mode, c, CompareFieldMask(tt.y, tt.x, tt.mode), tt.eq)
+		}
+	}
+
+	// Paths are not modified
+	if x := mask("b", "a"); !FieldMaskEqual(x, mask("a", "b"), UnknownCanonical) || x.Paths[0] != "b" {
+		t.Errorf("FieldMaskEqual() modified paths to %v", x.Paths)
+	}
+}
+
+func TestFieldMaskEqualAllocs(t *testing.T) {
+	x := &fieldmaskpb.FieldMask{Paths: []string{"b", "a.c", "a"}}
+	y := &fieldmaskpb.FieldMask{Paths: []string{"b", "a.c", "a"}}
+	if allocs := testing.AllocsPerRun(100, func() { FieldMaskEqual(x, y, UnknownCanonical) }); allocs != 0 {
+		t.Errorf("FieldMaskEqual() allocates %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,62 @@
+package equal
+
+import "math"
+
+// Float64Near reports whether a and b differ by at most the absolute
+// tolerance abs, the relative tolerance rel of the larger magnitude, or ulps
+// units in the last place. Zero tolerances are not checked. NaNs are never near
+// any value, callers compare them exactly beforehand. Infinities are near only
+// to infinities of the same sign.
+func Float64Near(a, b, abs, rel float64, ulps uint64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return a == b
+	}
+	d := math.Abs(a - b)
+	if abs > 0 && d <= abs {
+		return true
+	}
+	if rel > 0 && d <= rel*math.Max(math.Abs(a), math.Abs(b)) {
+		return true
+	}
+	return ulps > 0 && ulpDistance(orderedFloat64Bits(a), orderedFloat64Bits(b)) <= ulps
+}
+
+// Float32Near is Float64Near for float32 values, ulps counts float32 units in
+// the last place.
+func Float32Near(a, b float32, abs, rel float64, ulps uint64) bool {
+	if ulps > 0 && !math.IsNaN(float64(a)) && !math.IsNaN(float64(b)) &&
+		!math.IsInf(float64(a), 0) && !math.IsInf(float64(b), 0) &&
+		ulpDistance(orderedFloat32Bits(a), orderedFloat32Bits(b)) <= ulps {
+		return true
+	}
+	return Float64Near(float64(a), float64(b), abs, rel, 0)
+}
+
+// orderedFloat64Bits maps v to an integer with the same order as v, adjacent
+// floats map to adjacent integers and both zeros map to 0.
+func orderedFloat64Bits(v float64) int64 {
+	b := math.Float64bits(v)
+	if b>>63 != 0 {
+		return -int64(b &^ (1 << 63))
+	}
+	return int64(b)
+}
+
+// orderedFloat32Bits is orderedFloat64Bits for float32 values.
+func orderedFloat32Bits(v float32) int64 {
+	b := math.Float32bits(v)
+	if b>>31 != 0 {
+		return -int64(b &^ (1 << 31))
+	}
+	return int64(b)
+}
+
+func ulpDistance(a, b int64) uint64 {
+	if a > b {
+		return uint64(a) - uint64(b)
+	}
+	return uint64(b) - uint64(a)
+}
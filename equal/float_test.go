@@ -0,0 +1,59 @@
+package equal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64Near(t *testing.T) {
+	tests := []struct {
+		a, b, abs, rel float64
+		ulps           uint64
+		want           bool
+	}{
+		{a: 1, b: 1.5, abs: 0.5, want: true},
+		{a: 1, b: 1.6, abs: 0.5},
+		{a: 100, b: 101, rel: 0.01, want: true},
+		{a: 100, b: 102, rel: 0.01},
+		{a: 1, b: math.Nextafter(1, 2), ulps: 1, want: true},
+		{a: 1, b: math.Nextafter(math.Nextafter(1, 2), 2), ulps: 1},
+		{a: math.Copysign(0, -1), b: math.SmallestNonzeroFloat64, ulps: 1, want: true},
+		{a: -math.SmallestNonzeroFloat64, b: math.SmallestNonzeroFloat64, ulps: 1},
+		{a: math.MaxFloat64, b: math.Inf(1), ulps: 1},
+		{a: math.MaxFloat64, b: math.Inf(1), abs: 1, rel: 1},
+		{a: math.Inf(1), b: math.Inf(1), abs: 1, want: true},
+		{a: math.Inf(-1), b: math.Inf(-1), rel: 0.01, want: true},
+		{a: math.Inf(1), b: 1, rel: 0.01},
+		{a: math.Inf(1), b: 1, abs: math.MaxFloat64},
+		{a: 1, b: math.Inf(-1), rel: 0.01, ulps: 1},
+		{a: math.Inf(1), b: math.Inf(-1), abs: 1, rel: 0.01, ulps: 1},
+		{a: math.NaN(), b: math.NaN(), abs: 1, rel: 1, ulps: 1},
+		{a: 1, b: 1},
+	}
+	for _, tt := range tests {
+		if got := Float64Near(tt.a, tt.b, tt.abs, tt.rel, tt.ulps); got != tt.want {
+			t.Errorf("Float64Near(%v, %v, %v, %v, %v) = %v, want %v", tt.a, tt.b, tt.abs, tt.rel, tt.ulps, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32Near(t *testing.T) {
+	one := float32(1)
+	next := math.Nextafter32(one, 2)
+	if !Float32Near(one, next, 0, 0, 1) {
+		t.Errorf("Float32Near(%v, %v, 0, 0, 1) = false, want true", one, next)
+	}
+	if Float32Near(one, math.Nextafter32(next, 2), 0, 0, 1) {
+		t.Errorf("Float32Near(%v, %v, 0, 0, 1) = true, want false", one, math.Nextafter32(next, 2))
+	}
+
+	inf := float32(math.Inf(1))
+	for _, b := range []float32{1, math.MaxFloat32, -inf} {
+		if Float32Near(inf, b, 1, 0.01, 1) {
+			t.Errorf("Float32Near(%v, %v, 1, 0.01, 1) = true, want false", inf, b)
+		}
+	}
+	if !Float32Near(inf, inf, 0, 0.01, 0) {
+		t.Errorf("Float32Near(%v, %v, 0, 0.01, 0) = false, want true", inf, inf)
+	}
+}
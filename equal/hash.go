@@ -0,0 +1,114 @@
+package equal
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// canonicalNaN is hashed for every NaN, as Equal considers all NaNs equal.
+const canonicalNaN = 0x7ff8000000000001
+
+// HashUint64 writes v to h.
+func HashUint64(h *maphash.Hash, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	h.Write(b[:])
+}
+
+// HashBool writes v to h.
+func HashBool(h *maphash.Hash, v bool) {
+	if v {
+		h.WriteByte(1)
+	} else {
+		h.WriteByte(0)
+	}
+}
+
+// HashFloat64 writes v to h so that values equal under the float=equal and
+// float=proto policies hash the same: all NaNs hash alike and -0 hashes as +0.
+func HashFloat64(h *maphash.Hash, v float64) {
+	switch {
+	case math.IsNaN(v):
+		HashUint64(h, canonicalNaN)
+	case v == 0:
+		HashUint64(h, 0)
+	default:
+		HashUint64(h, math.Float64bits(v))
+	}
+}
+
+// HashString writes the length of v followed by v to h.
+func HashString(h *maphash.Hash, v string) {
+	HashUint64(h, uint64(len(v)))
+	h.WriteString(v)
+}
+
+// HashBytes writes the length of v followed by v to h.
+func HashBytes(h *maphash.Hash, v []byte) {
+	HashUint64(h, uint64(len(v)))
+	h.Write(v)
+}
+
+// HashExtensions writes the populated extension fields of m to h, hashing
+// floats consistently with Extensions in mode. Message values are hashed by
+// presence only, as they may be compared by their generated Equal methods.
+// Fields are hashed independently of the Range order.
+func HashExtensions(h *maphash.Hash, m protoreflect.Message, mode FloatMode) {
+	var n, sum uint64
+	var e maphash.Hash
+	e.SetSeed(h.Seed())
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() {
+			e.Reset()
+			HashUint64(&e, uint64(fd.Number()))
+			hashExtension(&e, fd, v, mode)
+			n++
+			sum += e.Sum64()
+		}
+		return true
+	})
+	HashUint64(h, n)
+	HashUint64(h, sum)
+}
+
+func hashExtension(h *maphash.Hash, fd protoreflect.FieldDescriptor, v protoreflect.Value, mode FloatMode) {
+	if !fd.IsList() {
+		hashValue(h, fd, v, mode)
+		return
+	}
+
+	l := v.List()
+	HashUint64(h, uint64(l.Len()))
+	for i := 0; i < l.Len(); i++ {
+		hashValue(h, fd, l.Get(i), mode)
+	}
+}
+
+func hashValue(h *maphash.Hash, fd protoreflect.FieldDescriptor, v protoreflect.Value, mode FloatMode) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		HashBool(h, v.Bool())
+	case protoreflect.EnumKind:
+		HashUint64(h, uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		HashUint64(h, uint64(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		HashUint64(h, v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if mode == FloatBits {
+			HashUint64(h, floatBits(fd, v.Float()))
+		} else {
+			HashFloat64(h, v.Float())
+		}
+	case protoreflect.StringKind:
+		HashString(h, v.String())
+	case protoreflect.BytesKind:
+		HashBytes(h, v.Bytes())
+	}
+}
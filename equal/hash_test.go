@@ -0,0 +1,34 @@
+package equal
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+)
+
+func TestHashFloat64(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hash := func(v float64) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashFloat64(&h, v)
+		return h.Sum64()
+	}
+
+	nan := math.NaN()
+	tests := []struct {
+		x, y float64
+		eq   bool
+	}{
+		{x: 1, y: 1, eq: true},
+		{x: 1, y: 2},
+		{x: 0, y: math.Copysign(0, -1), eq: true},
+		{x: nan, y: math.Float64frombits(math.Float64bits(nan) ^ 1), eq: true},
+		{x: nan, y: math.Inf(1)},
+	}
+	for _, tt := range tests {
+		if eq := hash(tt.x) == hash(tt.y); eq != tt.eq {
+			t.Errorf("HashFloat64(%v) == HashFloat64(%v) is %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package equal
+
+import "sort"
+
+// Match pairs elements of two slices having the same key. The N-th element
+// with a given key in one slice is paired with the N-th element with that key
+// in the other. X or Y is -1 when the other slice has no such element.
+type Match[K comparable] struct {
+	Key  K
+	N    int
+	X, Y int
+}
+
+// Path returns the path of the matched elements of field name, e.g.
+// items["a"] for the first element with key "a" and items["a"][1] for the
+// second one.
+func (m Match[K]) Path(name string) string {
+	if m.N == 0 {
+		return Key(name, m.Key)
+	}
+	return Index(Key(name, m.Key), m.N)
+}
+
+// MatchKeyed pairs elements of x and y by key. Matches are returned in the
+// order of elements of x followed by the unmatched elements of y.
+func MatchKeyed[T any, K comparable](x, y []T, key func(T) K) []Match[K] {
+	type occurrence struct {
+		key K
+		n   int
+	}
+
+	ys := make(map[occurrence]int, len(y))
+	yn := make([]int, len(y))
+	counts := make(map[K]int, len(y))
+	for j, v := range y {
+		k := key(v)
+		yn[j] = counts[k]
+		ys[occurrence{k, yn[j]}] = j
+		counts[k]++
+	}
+
+	matches := make([]Match[K], 0, len(x))
+	matched := make([]bool, len(y))
+	counts = make(map[K]int, len(x))
+	for i, v := range x {
+		k := key(v)
+		n := counts[k]
+		counts[k]++
+
+		j, ok := ys[occurrence{k, n}]
+		if ok {
+			matched[j] = true
+		} else {
+			j = -1
+		}
+		matches = append(matches, Match[K]{Key: k, N: n, X: i, Y: j})
+	}
+	for j, v := range y {
+		if !matched[j] {
+			matches = append(matches, Match[K]{Key: key(v), N: yn[j], X: -1, Y: j})
+		}
+	}
+	return matches
+}
+
+// Keyed reports whether x and y have equal elements when elements are matched
+// by key as by MatchKeyed.
+//
+// Slices with elements in the same order are compared without allocating.
+func Keyed[T any, K comparable](x, y []T, key func(T) K, equal func(a, b T) bool) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	// Fast path, skip the common prefix
+	k := 0
+	for k < len(x) && key(x[k]) == key(y[k]) && equal(x[k], y[k]) {
+		k++
+	}
+	if k == len(x) {
+		return true
+	}
+
+	for _, m := range MatchKeyed(x[k:], y[k:], key) {
+		if m.X < 0 || m.Y < 0 || !equal(x[k+m.X], y[k+m.Y]) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareKeyed orders x and y by comparing their elements stably sorted by
+// key lexicographically, first by key and then by compare. It returns 0
+// exactly when Keyed would report x and y equal under the equality defined
+// by compare.
+func CompareKeyed[T any, K comparable](x, y []T, key func(T) K, compareKey func(a, b K) int, compare func(a, b T) int) int {
+	byKey := func(s []T) []T {
+		c := make([]T, len(s))
+		copy(c, s)
+		sort.SliceStable(c, func(i, j int) bool { return compareKey(key(c[i]), key(c[j])) < 0 })
+		return c
+	}
+
+	sx, sy := byKey(x), byKey(y)
+	for i := 0; i < len(sx) && i < len(sy); i++ {
+		if c := compareKey(key(sx[i]), key(sy[i])); c != 0 {
+			return c
+		}
+		if c := compare(sx[i], sy[i]); c != 0 {
+			return c
+		}
+	}
+	return CompareOrdered(len(sx), len(sy))
+}
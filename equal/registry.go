@@ -0,0 +1,47 @@
+package equal
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// registry maps full names of messages to functions comparing them with
+// their generated Equal methods. It is written only during initialization.
+var registry = make(map[protoreflect.FullName]func(x, y proto.Message) (eq, ok bool))
+
+// Register registers the generated Equal method of message type T named
+// name, which Messages then uses for messages of that type. It is called from
+// init functions of generated files, e.g.
+// equal.Register("pkg.Msg", (*pkg.Msg).Equal), and must not be called
+// concurrently with Messages. A later registration of the same message
+// replaces an earlier one.
+func Register[T proto.Message](name protoreflect.FullName, equal func(x, y T) bool) {
+	registry[name] = func(x, y proto.Message) (bool, bool) {
+		tx, okx := x.(T)
+		ty, oky := y.(T)
+		if !okx || !oky {
+			return false, false
+		}
+		return equal(tx, ty), true
+	}
+}
+
+// Messages reports whether messages x and y are equal. Messages of the same
+// type with a registered Equal method are compared with it, other messages,
+// including messages of different types or of dynamic types, are compared
+// with proto.Equal.
+func Messages(x, y proto.Message) bool {
+	if x == nil || y == nil {
+		return proto.Equal(x, y)
+	}
+	name := x.ProtoReflect().Descriptor().FullName()
+	if name != y.ProtoReflect().Descriptor().FullName() {
+		return proto.Equal(x, y)
+	}
+	if equal, ok := registry[name]; ok {
+		if eq, ok := equal(x, y); ok {
+			return eq
+		}
+	}
+	return proto.Equal(x, y)
+}
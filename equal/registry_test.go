@@ -0,0 +1,46 @@
+package equal
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMessages(t *testing.T) {
+	// Register an Equal ignoring nanos
+	Register("google.protobuf.Duration", func(x, y *durationpb.Duration) bool {
+		return x.GetSeconds() == y.GetSeconds()
+	})
+	defer delete(registry, "google.protobuf.Duration")
+
+	dynamic := dynamicpb.NewMessage((&durationpb.Duration{}).ProtoReflect().Descriptor())
+	dynamic.Set(dynamic.Descriptor().Fields().ByName("nanos"), protoreflect.ValueOfInt32(2))
+
+	tests := []struct {
+		name string
+		x, y proto.Message
+		eq   bool
+	}{
+		{name: "registered", x: &durationpb.Duration{Seconds: 1, Nanos: 1}, y: &durationpb.Duration{Seconds: 1, Nanos: 2}, eq: true},
+		{name: "registered different", x: &durationpb.Duration{Seconds: 1}, y: &durationpb.Duration{Seconds: 2}},
+		{name: "unregistered", x: &timestamppb.Timestamp{Seconds: 1, Nanos: 1}, y: &timestamppb.Timestamp{Seconds: 1, Nanos: 2}},
+		{name: "unregistered equal", x: &timestamppb.Timestamp{Seconds: 1}, y: &timestamppb.Timestamp{Seconds: 1}, eq: true},
+		{name: "different types", x: &durationpb.Duration{Seconds: 1}, y: &timestamppb.Timestamp{Seconds: 1}},
+		{name: "dynamic", x: &durationpb.Duration{Nanos: 1}, y: dynamic},
+		{name: "dynamic equal", x: &durationpb.Duration{Nanos: 2}, y: dynamic, eq: true},
+		{name: "nil", x: nil, y: nil, eq: true},
+		{name: "nil and message", x: nil, y: &durationpb.Duration{}},
+	}
+	for _, tt := range tests {
+		if eq := Messages(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("%s: Messages(%v, %v) = %v, want %v", tt.name, tt.x, tt.y, eq, tt.eq)
+		}
+		if eq := Messages(tt.y, tt.x); eq != tt.eq {
+			t.Errorf("%s: Messages(%v, %v) = %v, want %v", tt.name, tt.y, tt.x, eq, tt.eq)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package equal
+
+import (
+	"hash/maphash"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// StringEqualFold reports whether a and b are equal under simple Unicode
+// case folding, as strings.EqualFold.
+func StringEqualFold(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// StringEqualNFC reports whether a and b are equal in Unicode Normalization
+// Form C. Strings already in NFC are compared without allocating.
+func StringEqualNFC(a, b string) bool {
+	return a == b || norm.NFC.String(a) == norm.NFC.String(b)
+}
+
+// FoldString returns s with every rune replaced by the smallest rune
+// equivalent under simple case folding, so strings equal under
+// StringEqualFold have equal results.
+func FoldString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		b.WriteRune(foldRune(r))
+	}
+	return b.String()
+}
+
+// NFCString returns s in Unicode Normalization Form C.
+func NFCString(s string) string {
+	return norm.NFC.String(s)
+}
+
+// HashStringFold writes s to h consistently with StringEqualFold.
+func HashStringFold(h *maphash.Hash, s string) {
+	var buf [utf8.UTFMax]byte
+	n := 0
+	for _, r := range s {
+		h.Write(buf[:utf8.EncodeRune(buf[:], foldRune(r))])
+		n++
+	}
+	HashUint64(h, uint64(n))
+}
+
+// HashStringNFC writes s to h consistently with StringEqualNFC.
+func HashStringNFC(h *maphash.Hash, s string) {
+	HashString(h, norm.NFC.String(s))
+}
+
+// CompareStringFold orders a and b consistently with StringEqualFold, by
+// their runes replaced as by FoldString.
+func CompareStringFold(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if c := CompareOrdered(foldRune(ra), foldRune(rb)); c != 0 {
+			return c
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return CompareOrdered(len(a), len(b))
+}
+
+// CompareStringNFC orders a and b consistently with StringEqualNFC, by their
+// Normalization Form C.
+func CompareStringNFC(a, b string) int {
+	if a == b {
+		return 0
+	}
+	return CompareOrdered(norm.NFC.String(a), norm.NFC.String(b))
+}
+
+// foldRune returns the smallest rune of the simple case folding orbit of r.
+func foldRune(r rune) rune {
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
+
+// NormalizedMap reports whether maps x and y have equal entries when their
+// keys are compared after normalize. Entries are matched as multisets, as
+// several keys of a map may normalize to the same key.
+//
+// Maps with the same keys and equal values are compared without allocating.
+func NormalizedMap[V any](x, y map[string]V, normalize func(string) string, equal func(a, b V) bool) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	// Fast path, maps with the same keys
+	same := true
+	for k, vx := range x {
+		if vy, ok := y[k]; !ok || !equal(vx, vy) {
+			same = false
+			break
+		}
+	}
+	if same {
+		return true
+	}
+
+	// Match each entry of x with an unused entry of y with the same
+	// normalized key and an equal value
+	ys := make(map[string][]V, len(y))
+	for k, v := range y {
+		n := normalize(k)
+		ys[n] = append(ys[n], v)
+	}
+	for k, vx := range x {
+		n := normalize(k)
+		vs := ys[n]
+		found := false
+		for i, vy := range vs {
+			if equal(vx, vy) {
+				vs[i] = vs[len(vs)-1]
+				ys[n] = vs[:len(vs)-1]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareNormalizedMap orders maps x and y consistently with NormalizedMap,
+// by comparing their entries sorted by normalized key and then by compare
+// lexicographically.
+func CompareNormalizedMap[V any](x, y map[string]V, normalize func(string) string, compare func(a, b V) int) int {
+	type entry struct {
+		key   string
+		value V
+	}
+	sorted := func(m map[string]V) []entry {
+		s := make([]entry, 0, len(m))
+		for k, v := range m {
+			s = append(s, entry{normalize(k), v})
+		}
+		sort.Slice(s, func(i, j int) bool {
+			if c := CompareOrdered(s[i].key, s[j].key); c != 0 {
+				return c < 0
+			}
+			return compare(s[i].value, s[j].value) < 0
+		})
+		return s
+	}
+
+	sx, sy := sorted(x), sorted(y)
+	for i := 0; i < len(sx) && i < len(sy); i++ {
+		if c := CompareOrdered(sx[i].key, sy[i].key); c != 0 {
+			return c
+		}
+		if c := compare(sx[i].value, sy[i].value); c != 0 {
+			return c
+		}
+	}
+	return CompareOrdered(len(sx), len(sy))
+}
@@ -0,0 +1,95 @@
+package equal
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestStringFold(t *testing.T) {
+	tests := []struct {
+		a, b string
+		eq   bool
+	}{
+		{a: "Go", b: "GO", eq: true},
+		{a: "\u212a", b: "k", eq: true},  // Kelvin sign
+		{a: "\u017f", b: "S", eq: true},  // long s
+		{a: "stra\u00dfe", b: "STRASSE"}, // full case folding is not applied
+		{a: "a", b: "ab"},
+		{a: "\xff", b: "\xfe", eq: true}, // invalid UTF-8 decodes to utf8.RuneError
+	}
+
+	seed := maphash.MakeSeed()
+	hash := func(s string) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashStringFold(&h, s)
+		return h.Sum64()
+	}
+
+	for _, tt := range tests {
+		if eq := StringEqualFold(tt.a, tt.b); eq != tt.eq {
+			t.Errorf("StringEqualFold(%q, %q) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+		if eq := FoldString(tt.a) == FoldString(tt.b); eq != tt.eq {
+			t.Errorf("FoldString(%q) == FoldString(%q) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+		if tt.eq && hash(tt.a) != hash(tt.b) {
+			t.Errorf("HashStringFold(%q) != HashStringFold(%q) for equal strings", tt.a, tt.b)
+		}
+		if c := CompareStringFold(tt.a, tt.b); (c == 0) != tt.eq || c != -CompareStringFold(tt.b, tt.a) {
+			t.Errorf("CompareStringFold(%q, %q) = %v, want equal %v", tt.a, tt.b, c, tt.eq)
+		}
+	}
+}
+
+func TestStringNFC(t *testing.T) {
+	const (
+		composed   = "caf\u00e9"
+		decomposed = "cafe\u0301"
+	)
+	if !StringEqualNFC(composed, decomposed) {
+		t.Errorf("StringEqualNFC(%q, %q) = false, want true", composed, decomposed)
+	}
+	if StringEqualNFC(composed, "cafe") {
+		t.Errorf("StringEqualNFC(%q, %q) = true, want false", composed, "cafe")
+	}
+	if c := CompareStringNFC(composed, decomposed); c != 0 {
+		t.Errorf("CompareStringNFC(%q, %q) = %v, want 0", composed, decomposed, c)
+	}
+	if NFCString(decomposed) != composed {
+		t.Errorf("NFCString(%q) = %q, want %q", decomposed, NFCString(decomposed), composed)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { StringEqualNFC(composed, "caf\u00e8") }); allocs != 0 {
+		t.Errorf("StringEqualNFC() of strings in NFC allocates %v times, want 0", allocs)
+	}
+}
+
+func TestNormalizedMap(t *testing.T) {
+	equalInt := func(a, b int) bool { return a == b }
+	compareInt := func(a, b int) int { return CompareOrdered(a, b) }
+
+	tests := []struct {
+		x, y map[string]int
+		eq   bool
+	}{
+		{x: map[string]int{"a": 1}, y: map[string]int{"a": 1}, eq: true},
+		{x: map[string]int{"a": 1}, y: map[string]int{"A": 1}, eq: true},
+		{x: map[string]int{"a": 1}, y: map[string]int{"A": 2}},
+		{x: map[string]int{"a": 1, "A": 2}, y: map[string]int{"A": 1, "a": 2}, eq: true},
+		{x: map[string]int{"a": 1, "A": 1}, y: map[string]int{"a": 1, "b": 1}},
+		{x: map[string]int{"a": 1, "b": 2}, y: map[string]int{"a": 1}},
+	}
+	for _, tt := range tests {
+		if eq := NormalizedMap(tt.x, tt.y, FoldString, equalInt); eq != tt.eq {
+			t.Errorf("NormalizedMap(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+		if c := CompareNormalizedMap(tt.x, tt.y, FoldString, compareInt); (c == 0) != tt.eq || c != -CompareNormalizedMap(tt.y, tt.x, FoldString, compareInt) {
+			t.Errorf("CompareNormalizedMap(%v, %v) = %v, want equal %v", tt.x, tt.y, c, tt.eq)
+		}
+	}
+
+	x, y := map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}
+	if allocs := testing.AllocsPerRun(100, func() { NormalizedMap(x, y, FoldString, equalInt) }); allocs != 0 {
+		t.Errorf("NormalizedMap() of maps with the same keys allocates %v times, want 0", allocs)
+	}
+}
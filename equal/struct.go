@@ -0,0 +1,273 @@
+package equal
+
+import (
+	"hash/maphash"
+	"math"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// StructEqual reports whether Struct messages x and y are equal as by
+// proto.Equal without reflection: number values are equal when numerically
+// equal or both NaN. Unknown fields are compared in mode.
+func StructEqual(x, y *structpb.Struct, mode UnknownMode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil || len(x.Fields) != len(y.Fields) {
+		return false
+	}
+	for k, vx := range x.Fields {
+		vy, ok := y.Fields[k]
+		if !ok || !ValueEqual(vx, vy, mode) {
+			return false
+		}
+	}
+	return unknownEqual(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// ValueEqual is StructEqual for Value messages.
+func ValueEqual(x, y *structpb.Value, mode UnknownMode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return false
+	}
+	switch vx := x.Kind.(type) {
+	case nil:
+		if y.Kind != nil {
+			return false
+		}
+	case *structpb.Value_NullValue:
+		vy, ok := y.Kind.(*structpb.Value_NullValue)
+		if !ok || vx.NullValue != vy.NullValue {
+			return false
+		}
+	case *structpb.Value_NumberValue:
+		vy, ok := y.Kind.(*structpb.Value_NumberValue)
+		if !ok || vx.NumberValue != vy.NumberValue && !(math.IsNaN(vx.NumberValue) && math.IsNaN(vy.NumberValue)) {
+			return false
+		}
+	case *structpb.Value_StringValue:
+		vy, ok := y.Kind.(*structpb.Value_StringValue)
+		if !ok || vx.StringValue != vy.StringValue {
+			return false
+		}
+	case *structpb.Value_BoolValue:
+		vy, ok := y.Kind.(*structpb.Value_BoolValue)
+		if !ok || vx.BoolValue != vy.BoolValue {
+			return false
+		}
+	case *structpb.Value_StructValue:
+		vy, ok := y.Kind.(*structpb.Value_StructValue)
+		if !ok || !StructEqual(structOrEmpty(vx.StructValue), structOrEmpty(vy.StructValue), mode) {
+			return false
+		}
+	case *structpb.Value_ListValue:
+		vy, ok := y.Kind.(*structpb.Value_ListValue)
+		if !ok || !ListValueEqual(listOrEmpty(vx.ListValue), listOrEmpty(vy.ListValue), mode) {
+			return false
+		}
+	}
+	return unknownEqual(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// ListValueEqual is StructEqual for ListValue messages.
+func ListValueEqual(x, y *structpb.ListValue, mode UnknownMode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil || len(x.Values) != len(y.Values) {
+		return false
+	}
+	for i := range x.Values {
+		if !ValueEqual(x.Values[i], y.Values[i], mode) {
+			return false
+		}
+	}
+	return unknownEqual(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// HashStruct writes Struct x to h consistently with StructEqual. Fields are
+// hashed separately and summed, so the result does not depend on the map
+// iteration order.
+func HashStruct(h *maphash.Hash, x *structpb.Struct, mode UnknownMode) {
+	HashBool(h, x != nil)
+	if x == nil {
+		return
+	}
+	var sum uint64
+	for k, v := range x.Fields {
+		var e maphash.Hash
+		e.SetSeed(h.Seed())
+		HashString(&e, k)
+		HashValue(&e, v, mode)
+		sum += e.Sum64()
+	}
+	HashUint64(h, uint64(len(x.Fields)))
+	HashUint64(h, sum)
+	hashUnknown(h, x.ProtoReflect().GetUnknown(), mode)
+}
+
+// HashValue writes Value x to h consistently with ValueEqual.
+func HashValue(h *maphash.Hash, x *structpb.Value, mode UnknownMode) {
+	HashBool(h, x != nil)
+	if x == nil {
+		return
+	}
+	switch v := x.Kind.(type) {
+	case nil:
+		HashUint64(h, 0)
+	case *structpb.Value_NullValue:
+		HashUint64(h, 1)
+		HashUint64(h, uint64(v.NullValue))
+	case *structpb.Value_NumberValue:
+		HashUint64(h, 2)
+		HashFloat64(h, v.NumberValue)
+	case *structpb.Value_StringValue:
+		HashUint64(h, 3)
+		HashString(h, v.StringValue)
+	case *structpb.Value_BoolValue:
+		HashUint64(h, 4)
+		HashBool(h, v.BoolValue)
+	case *structpb.Value_StructValue:
+		HashUint64(h, 5)
+		HashStruct(h, structOrEmpty(v.StructValue), mode)
+	case *structpb.Value_ListValue:
+		HashUint64(h, 6)
+		HashListValue(h, listOrEmpty(v.ListValue), mode)
+	}
+	hashUnknown(h, x.ProtoReflect().GetUnknown(), mode)
+}
+
+// HashListValue writes ListValue x to h consistently with ListValueEqual.
+func HashListValue(h *maphash.Hash, x *structpb.ListValue, mode UnknownMode) {
+	HashBool(h, x != nil)
+	if x == nil {
+		return
+	}
+	HashUint64(h, uint64(len(x.Values)))
+	for _, v := range x.Values {
+		HashValue(h, v, mode)
+	}
+	hashUnknown(h, x.ProtoReflect().GetUnknown(), mode)
+}
+
+// CompareStruct orders Struct messages consistently with StructEqual, like
+// generated Compare methods order maps: fields are walked in sorted key
+// order and a struct having a key the other lacks sorts first.
+func CompareStruct(x, y *structpb.Struct, mode UnknownMode) int {
+	if x == y {
+		return 0
+	}
+	if x == nil || y == nil {
+		return CompareBool(x != nil, y != nil)
+	}
+	for _, k := range SortedKeys(x.Fields, y.Fields) {
+		vx, okx := x.Fields[k]
+		vy, oky := y.Fields[k]
+		if okx != oky {
+			return CompareBool(oky, okx)
+		}
+		if c := CompareValue(vx, vy, mode); c != 0 {
+			return c
+		}
+	}
+	return compareUnknown(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// CompareValue orders Value messages consistently with ValueEqual, by the
+// field number of the set kind and then by its value. Numbers are ordered as
+// by CompareFloat64.
+func CompareValue(x, y *structpb.Value, mode UnknownMode) int {
+	if x == y {
+		return 0
+	}
+	if x == nil || y == nil {
+		return CompareBool(x != nil, y != nil)
+	}
+	if c := CompareOrdered(valueKind(x), valueKind(y)); c != 0 {
+		return c
+	}
+	var c int
+	switch vx := x.Kind.(type) {
+	case *structpb.Value_NullValue:
+		c = CompareOrdered(vx.NullValue, y.GetNullValue())
+	case *structpb.Value_NumberValue:
+		c = CompareFloat64(vx.NumberValue, y.GetNumberValue())
+	case *structpb.Value_StringValue:
+		c = CompareOrdered(vx.StringValue, y.GetStringValue())
+	case *structpb.Value_BoolValue:
+		c = CompareBool(vx.BoolValue, y.GetBoolValue())
+	case *structpb.Value_StructValue:
+		c = CompareStruct(structOrEmpty(vx.StructValue), structOrEmpty(y.GetStructValue()), mode)
+	case *structpb.Value_ListValue:
+		c = CompareListValue(listOrEmpty(vx.ListValue), listOrEmpty(y.GetListValue()), mode)
+	}
+	if c != 0 {
+		return c
+	}
+	return compareUnknown(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// CompareListValue orders ListValue messages consistently with
+// ListValueEqual, lexicographically by their values.
+func CompareListValue(x, y *structpb.ListValue, mode UnknownMode) int {
+	if x == y {
+		return 0
+	}
+	if x == nil || y == nil {
+		return CompareBool(x != nil, y != nil)
+	}
+	for i := 0; i < len(x.Values) && i < len(y.Values); i++ {
+		if c := CompareValue(x.Values[i], y.Values[i], mode); c != 0 {
+			return c
+		}
+	}
+	if c := CompareOrdered(len(x.Values), len(y.Values)); c != 0 {
+		return c
+	}
+	return compareUnknown(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown(), mode)
+}
+
+// valueKind returns the field number of the kind set in v, or 0 if unset.
+func valueKind(v *structpb.Value) int {
+	switch v.Kind.(type) {
+	case *structpb.Value_NullValue:
+		return 1
+	case *structpb.Value_NumberValue:
+		return 2
+	case *structpb.Value_StringValue:
+		return 3
+	case *structpb.Value_BoolValue:
+		return 4
+	case *structpb.Value_StructValue:
+		return 5
+	case *structpb.Value_ListValue:
+		return 6
+	}
+	return 0
+}
+
+var (
+	emptyStruct    = &structpb.Struct{}
+	emptyListValue = &structpb.ListValue{}
+)
+
+// structOrEmpty returns s or an empty Struct if s is nil, as a set oneof
+// member holding nil is equal to an empty message under proto.Equal.
+func structOrEmpty(s *structpb.Struct) *structpb.Struct {
+	if s == nil {
+		return emptyStruct
+	}
+	return s
+}
+
+// listOrEmpty is structOrEmpty for ListValue.
+func listOrEmpty(l *structpb.ListValue) *structpb.ListValue {
+	if l == nil {
+		return emptyListValue
+	}
+	return l
+}
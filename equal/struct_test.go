@@ -0,0 +1,123 @@
+package equal
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStructEqual(t *testing.T) {
+	newStruct := func(v map[string]interface{}) *structpb.Struct {
+		s, err := structpb.NewStruct(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	withUnknown := func(v *structpb.Value, b ...byte) *structpb.Value {
+		v.ProtoReflect().SetUnknown(b)
+		return v
+	}
+
+	tests := []struct {
+		x, y *structpb.Value
+	}{
+		{structpb.NewNullValue(), structpb.NewNullValue()},
+		{structpb.NewNullValue(), &structpb.Value{}},
+		{structpb.NewNumberValue(1), structpb.NewNumberValue(1)},
+		{structpb.NewNumberValue(1), structpb.NewNumberValue(2)},
+		{structpb.NewNumberValue(math.NaN()), structpb.NewNumberValue(math.NaN())},
+		{structpb.NewNumberValue(math.NaN()), structpb.NewNumberValue(0)},
+		{structpb.NewNumberValue(math.Copysign(0, -1)), structpb.NewNumberValue(0)},
+		{structpb.NewNumberValue(0), structpb.NewStringValue("")},
+		{structpb.NewStringValue("a"), structpb.NewStringValue("a")},
+		{structpb.NewBoolValue(true), structpb.NewBoolValue(false)},
+		{structpb.NewStructValue(newStruct(map[string]interface{}{"a": 1, "b": "c"})), structpb.NewStructValue(newStruct(map[string]interface{}{"a": 1, "b": "c"}))},
+		{structpb.NewStructValue(newStruct(map[string]interface{}{"a": 1})), structpb.NewStructValue(newStruct(map[string]interface{}{"a": 1, "b": "c"}))},
+		{structpb.NewStructValue(newStruct(map[string]interface{}{"a": 1})), structpb.NewStructValue(newStruct(map[string]interface{}{"b": 1}))},
+		{structpb.NewStructValue(nil), structpb.NewStructValue(&structpb.Struct{})},
+		{structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(math.NaN())}}), structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(math.NaN())}})},
+		{structpb.NewListValue(&structpb.ListValue{}), structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNullValue()}})},
+		{withUnknown(structpb.NewNullValue(), 0x50, 1), structpb.NewNullValue()},
+		{withUnknown(structpb.NewNullValue(), 0x50, 1), withUnknown(structpb.NewNullValue(), 0x50, 1)},
+	}
+
+	seed := maphash.MakeSeed()
+	hash := func(v *structpb.Value) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashValue(&h, v, UnknownCanonical)
+		return h.Sum64()
+	}
+
+	for _, tt := range tests {
+		want := proto.Equal(tt.x, tt.y)
+		if eq := ValueEqual(tt.x, tt.y, UnknownCanonical); eq != want {
+			t.Errorf("ValueEqual(%v, %v) = %v, want %v", tt.x, tt.y, eq, want)
+		}
+		if want && hash(tt.x) != hash(tt.y) {
+			t.Errorf("HashValue(%v) != HashValue(%v) for equal values", tt.x, tt.y)
+		}
+		if c := CompareValue(tt.x, tt.y, UnknownCanonical); (c == 0) != want || c != -CompareValue(tt.y, tt.x, UnknownCanonical) {
+			t.Errorf("CompareValue(%v, %v) = %v, CompareValue(y, x) = %v, want equal %v", tt.x, tt.y, c, CompareValue(tt.y, tt.x, UnknownCanonical), want)
+		}
+	}
+}
+
+func TestStructEqualUnknown(t *testing.T) {
+	withUnknown := func(b ...byte) *structpb.Struct {
+		s := &structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNullValue()}}
+		s.Fields["a"].ProtoReflect().SetUnknown(b)
+		return s
+	}
+	// Unknown fields 10: 1 and 11: 2, then 10: 1 and 10: 2
+	x, reordered, other := withUnknown(0x50, 1, 0x58, 2), withUnknown(0x58, 2, 0x50, 1), withUnknown(0x50, 1, 0x50, 2)
+
+	tests := []struct {
+		mode          UnknownMode
+		reordered, eq bool
+	}{
+		{mode: UnknownCanonical, reordered: true},
+		{mode: UnknownRaw},
+		{mode: UnknownIgnore, reordered: true, eq: true},
+	}
+
+	seed := maphash.MakeSeed()
+	hash := func(s *structpb.Struct, mode UnknownMode) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashStruct(&h, s, mode)
+		return h.Sum64()
+	}
+
+	for _, tt := range tests {
+		for _, y := range []struct {
+			s  *structpb.Struct
+			eq bool
+		}{{reordered, tt.reordered}, {other, tt.eq}} {
+			if eq := StructEqual(x, y.s, tt.mode); eq != y.eq {
+				t.Errorf("StructEqual(%v, %v, %v) = %v, want %v", x, y.s, tt.mode, eq, y.eq)
+			}
+			if y.eq && hash(x, tt.mode) != hash(y.s, tt.mode) {
+				t.Errorf("HashStruct(%v, %v) != HashStruct(%v, %v) for equal structs", x, tt.mode, y.s, tt.mode)
+			}
+			if c := CompareStruct(x, y.s, tt.mode); (c == 0) != y.eq || c != -CompareStruct(y.s, x, tt.mode) {
+				t.Errorf("CompareStruct(%v, %v, %v) = %v, want equal %v", x, y.s, tt.mode, c, y.eq)
+			}
+		}
+	}
+}
+
+func TestStructEqualAllocs(t *testing.T) {
+	x, err := structpb.NewStruct(map[string]interface{}{"a": 1.5, "b": []interface{}{"c", true, nil, map[string]interface{}{"d": "e"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := proto.Clone(x).(*structpb.Struct)
+	if allocs := testing.AllocsPerRun(100, func() { StructEqual(x, y, UnknownCanonical) }); allocs != 0 {
+		t.Errorf("StructEqual() allocates %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,60 @@
+package equal
+
+import "hash/maphash"
+
+const nanosPerSecond = 1e9
+
+// NormalizeTime returns the seconds and nanos of a Timestamp or Duration
+// representing the same instant or duration with 0 <= nanos < 1e9, so
+// equal values have equal representations.
+func NormalizeTime(seconds int64, nanos int32) (int64, int32) {
+	seconds += int64(nanos / nanosPerSecond)
+	nanos %= nanosPerSecond
+	if nanos < 0 {
+		seconds--
+		nanos += nanosPerSecond
+	}
+	return seconds, nanos
+}
+
+// TimeEqual reports whether Timestamp or Duration values x and y represent
+// the same instant or duration.
+func TimeEqual(xs int64, xn int32, ys int64, yn int32) bool {
+	xs, xn = NormalizeTime(xs, xn)
+	ys, yn = NormalizeTime(ys, yn)
+	return xs == ys && xn == yn
+}
+
+// TimeNear reports whether Timestamp or Duration values x and y differ by at
+// most tolerance nanoseconds.
+func TimeNear(xs int64, xn int32, ys int64, yn int32, tolerance int64) bool {
+	xs, xn = NormalizeTime(xs, xn)
+	ys, yn = NormalizeTime(ys, yn)
+	if xs < ys || xs == ys && xn < yn {
+		xs, xn, ys, yn = ys, yn, xs, xn
+	}
+
+	// Seconds may be far apart, check them before computing nanoseconds
+	if d := uint64(xs) - uint64(ys); d > uint64(tolerance)/nanosPerSecond+1 {
+		return false
+	}
+	return (xs-ys)*nanosPerSecond+int64(xn)-int64(yn) <= tolerance
+}
+
+// CompareTime orders Timestamp or Duration values x and y chronologically.
+func CompareTime(xs int64, xn int32, ys int64, yn int32) int {
+	xs, xn = NormalizeTime(xs, xn)
+	ys, yn = NormalizeTime(ys, yn)
+	if c := CompareOrdered(xs, ys); c != 0 {
+		return c
+	}
+	return CompareOrdered(xn, yn)
+}
+
+// HashTime writes the normalized Timestamp or Duration value to h, so values
+// equal under TimeEqual have equal hashes.
+func HashTime(h *maphash.Hash, seconds int64, nanos int32) {
+	seconds, nanos = NormalizeTime(seconds, nanos)
+	HashUint64(h, uint64(seconds))
+	HashUint64(h, uint64(nanos))
+}
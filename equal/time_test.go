@@ -0,0 +1,79 @@
+package equal
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNormalizeTime(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		nanos   int32
+		s       int64
+		n       int32
+	}{
+		{0, 0, 0, 0},
+		{1, 1e9, 2, 0},
+		{1, 2500000000 - 1e9, 2, 500000000},
+		{1, -1, 0, 999999999},
+		{-1, -500000000, -2, 500000000},
+		{0, -1e9, -1, 0},
+	}
+	for _, tt := range tests {
+		if s, n := NormalizeTime(tt.seconds, tt.nanos); s != tt.s || n != tt.n {
+			t.Errorf("NormalizeTime(%v, %v) = %v, %v, want %v, %v", tt.seconds, tt.nanos, s, n, tt.s, tt.n)
+		}
+	}
+}
+
+func TestTimeNear(t *testing.T) {
+	ms := int64(time.Millisecond)
+	tests := []struct {
+		xs        int64
+		xn        int32
+		ys        int64
+		yn        int32
+		tolerance int64
+		want      bool
+	}{
+		{1, 0, 1, 0, 0, true},
+		{1, 0, 0, 1e9, 0, true},
+		{1, 0, 1, int32(ms), ms, true},
+		{1, 0, 1, int32(ms) + 1, ms, false},
+		{1, int32(ms) + 1, 1, 0, ms, false},
+		{2, 0, 1, 999999999, 1, true},
+		{-1, 0, 0, -1e9 + int32(ms), ms, true},
+		{math.MaxInt64, 0, math.MinInt64, 0, math.MaxInt64, false},
+		{math.MinInt64, 0, math.MaxInt64, 0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := TimeNear(tt.xs, tt.xn, tt.ys, tt.yn, tt.tolerance); got != tt.want {
+			t.Errorf("TimeNear(%v, %v, %v, %v, %v) = %v, want %v", tt.xs, tt.xn, tt.ys, tt.yn, tt.tolerance, got, tt.want)
+		}
+	}
+}
+
+func TestCompareTime(t *testing.T) {
+	if c := CompareTime(1, 0, 0, 1e9); c != 0 {
+		t.Errorf("CompareTime(1, 0, 0, 1e9) = %v, want 0", c)
+	}
+	if c := CompareTime(-1, -1, -1, 0); c != -1 {
+		t.Errorf("CompareTime(-1, -1, -1, 0) = %v, want -1", c)
+	}
+	if c := CompareTime(0, 1, 0, 0); c != 1 {
+		t.Errorf("CompareTime(0, 1, 0, 0) = %v, want 1", c)
+	}
+
+	seed := maphash.MakeSeed()
+	hash := func(seconds int64, nanos int32) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		HashTime(&h, seconds, nanos)
+		return h.Sum64()
+	}
+	if hash(1, -1) != hash(0, 999999999) {
+		t.Errorf("HashTime(1, -1) != HashTime(0, 999999999)")
+	}
+}
@@ -0,0 +1,104 @@
+// Package equal contains runtime helpers used by code generated by
+// protoc-gen-go-equal.
+package equal
+
+import (
+	"bytes"
+	"hash/maphash"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnknownMode selects how unknown fields of well-known types compared by
+// helpers of this package are compared, matching the unknown parameter of the
+// generated code.
+type UnknownMode int
+
+const (
+	// UnknownCanonical compares unknown fields as UnknownFields.
+	UnknownCanonical UnknownMode = iota
+
+	// UnknownRaw compares the raw bytes of unknown fields.
+	UnknownRaw
+
+	// UnknownIgnore ignores unknown fields.
+	UnknownIgnore
+)
+
+// unknownEqual reports whether unknown fields x and y are equal in mode.
+func unknownEqual(x, y protoreflect.RawFields, mode UnknownMode) bool {
+	switch mode {
+	case UnknownRaw:
+		return string(x) == string(y)
+	case UnknownCanonical:
+		return UnknownFields(x, y)
+	}
+	return true
+}
+
+// hashUnknown writes unknown fields b to h consistently with unknownEqual.
+func hashUnknown(h *maphash.Hash, b protoreflect.RawFields, mode UnknownMode) {
+	switch mode {
+	case UnknownRaw:
+		HashBytes(h, b)
+	case UnknownCanonical:
+		// Reordering unknown fields keeps their total length
+		HashUint64(h, uint64(len(b)))
+	}
+}
+
+// compareUnknown orders unknown fields x and y consistently with
+// unknownEqual.
+func compareUnknown(x, y protoreflect.RawFields, mode UnknownMode) int {
+	switch mode {
+	case UnknownRaw:
+		return bytes.Compare(x, y)
+	case UnknownCanonical:
+		return CompareUnknownFields(x, y)
+	}
+	return 0
+}
+
+// UnknownFields reports whether the unknown fields x and y are equal.
+//
+// Fields with different field numbers may appear in any order, while fields
+// sharing a field number must appear in the same relative order. This matches
+// the way proto.Equal compares unknown fields.
+func UnknownFields(x, y protoreflect.RawFields) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	if string(x) == string(y) {
+		return true
+	}
+
+	mx, ok := groupUnknownFields(x)
+	if !ok {
+		return false
+	}
+	my, ok := groupUnknownFields(y)
+	if !ok || len(mx) != len(my) {
+		return false
+	}
+	for num, bx := range mx {
+		if by, ok := my[num]; !ok || string(bx) != string(by) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupUnknownFields concatenates the raw unknown fields by field number.
+func groupUnknownFields(b protoreflect.RawFields) (map[protowire.Number][]byte, bool) {
+	m := make(map[protowire.Number][]byte)
+	for len(b) > 0 {
+		num, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return nil, false
+		}
+		m[num] = append(m[num], b[:n]...)
+		b = b[n:]
+	}
+	return m, true
+}
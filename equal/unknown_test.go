@@ -0,0 +1,69 @@
+package equal
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestUnknownFields(t *testing.T) {
+	field := func(num protowire.Number, v uint64) []byte {
+		b := protowire.AppendTag(nil, num, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+	concat := func(bs ...[]byte) []byte {
+		var out []byte
+		for _, b := range bs {
+			out = append(out, b...)
+		}
+		return out
+	}
+
+	tests := []struct {
+		x, y []byte
+		eq   bool
+	}{
+		{
+			x:  nil,
+			y:  nil,
+			eq: true,
+		}, {
+			x:  nil,
+			y:  []byte{},
+			eq: true,
+		}, {
+			x: field(1, 1),
+			y: nil,
+		}, {
+			x:  field(1, 1),
+			y:  field(1, 1),
+			eq: true,
+		}, {
+			x: field(1, 1),
+			y: field(1, 2),
+		}, {
+			x: field(1, 1),
+			y: field(2, 1),
+		}, {
+			x:  concat(field(1, 1), field(2, 2)),
+			y:  concat(field(2, 2), field(1, 1)),
+			eq: true,
+		}, {
+			x: concat(field(1, 1), field(1, 2)),
+			y: concat(field(1, 2), field(1, 1)),
+		}, {
+			x:  concat(field(1, 1), field(2, 2), field(1, 3)),
+			y:  concat(field(2, 2), field(1, 1), field(1, 3)),
+			eq: true,
+		}, {
+			x: concat(field(1, 1), []byte{0xff}),
+			y: concat([]byte{0xff}, field(1, 1)),
+		},
+	}
+
+	for _, tt := range tests {
+		if eq := UnknownFields(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("UnknownFields(%x, %x) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+	}
+}
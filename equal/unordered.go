@@ -0,0 +1,77 @@
+package equal
+
+import (
+	"hash/maphash"
+	"sort"
+)
+
+// Unordered reports whether x and y contain the same elements with the same
+// multiplicities according to equal, which must be an equivalence relation.
+//
+// Slices with equal elements in the same order are compared without
+// allocating.
+func Unordered[T any](x, y []T, equal func(a, b T) bool) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	// Fast path, skip the common prefix
+	k := 0
+	for k < len(x) && equal(x[k], y[k]) {
+		k++
+	}
+	if k == len(x) {
+		return true
+	}
+
+	// Match each remaining element of x with an unused equal element of y
+	used := make([]bool, len(y)-k)
+	for _, a := range x[k:] {
+		found := false
+		for j, b := range y[k:] {
+			if !used[j] && equal(a, b) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareUnordered orders x and y by comparing their elements sorted by
+// compare lexicographically. It returns 0 exactly when Unordered would
+// report x and y equal under the equality defined by compare.
+func CompareUnordered[T any](x, y []T, compare func(a, b T) int) int {
+	sx, sy := sortedCopy(x, compare), sortedCopy(y, compare)
+	for i := 0; i < len(sx) && i < len(sy); i++ {
+		if c := compare(sx[i], sy[i]); c != 0 {
+			return c
+		}
+	}
+	return CompareOrdered(len(sx), len(sy))
+}
+
+func sortedCopy[T any](s []T, compare func(a, b T) int) []T {
+	c := make([]T, len(s))
+	copy(c, s)
+	sort.SliceStable(c, func(i, j int) bool { return compare(c[i], c[j]) < 0 })
+	return c
+}
+
+// HashUnordered writes the elements of x to h independently of their order.
+// Each element is hashed by hash separately and the results are summed.
+func HashUnordered[T any](h *maphash.Hash, x []T, hash func(h *maphash.Hash, v T)) {
+	var sum uint64
+	for _, v := range x {
+		var e maphash.Hash
+		e.SetSeed(h.Seed())
+		hash(&e, v)
+		sum += e.Sum64()
+	}
+	HashUint64(h, uint64(len(x)))
+	HashUint64(h, sum)
+}
@@ -0,0 +1,96 @@
+package equal
+
+import "math"
+
+// float is the set of Go types of protobuf floats.
+type float interface {
+	~float32 | ~float64
+}
+
+// OptionalEqual reports whether optional scalars p and q are both unset or
+// both set to equal values.
+func OptionalEqual[T comparable](p, q *T) bool {
+	return p == q || p != nil && q != nil && *p == *q
+}
+
+// FloatEqual reports whether a and b are equal numerically, with NaNs equal
+// to each other.
+func FloatEqual[T float](a, b T) bool {
+	return a == b || a != a && b != b
+}
+
+// FloatEqualSignedZero is FloatEqual distinguishing -0 from +0, as
+// proto.Equal does for floats without presence.
+func FloatEqualSignedZero[T float](a, b T) bool {
+	return FloatEqual(a, b) && (a != 0 || math.Signbit(float64(a)) == math.Signbit(float64(b)))
+}
+
+// OptionalFloatEqual reports whether optional floats p and q are both unset
+// or both set to values equal as by FloatEqual.
+func OptionalFloatEqual[T float](p, q *T) bool {
+	return p == q || p != nil && q != nil && FloatEqual(*p, *q)
+}
+
+// BytesEqual reports whether a and b hold the same bytes. Nil and empty
+// slices are equal.
+func BytesEqual(a, b []byte) bool {
+	return string(a) == string(b)
+}
+
+// OptionalBytesEqual reports whether optional bytes a and b are both unset
+// (nil) or both set to the same bytes.
+func OptionalBytesEqual(a, b []byte) bool {
+	return (a == nil) == (b == nil) && string(a) == string(b)
+}
+
+// SliceEqual reports whether x and y have equal elements in the same order.
+func SliceEqual[T comparable](x, y []T) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SliceEqualFunc is SliceEqual comparing elements with equal.
+func SliceEqualFunc[T any](x, y []T, equal func(a, b T) bool) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if !equal(x[i], y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapEqual reports whether x and y have the same keys with equal values.
+func MapEqual[K, V comparable](x, y map[K]V) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for k, v := range x {
+		if w, ok := y[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// MapEqualFunc is MapEqual comparing values with equal.
+func MapEqualFunc[K comparable, V any](x, y map[K]V, equal func(a, b V) bool) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for k, v := range x {
+		if w, ok := y[k]; !ok || !equal(v, w) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package equal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOptionalEqual(t *testing.T) {
+	one, one2, two := int32(1), int32(1), int32(2)
+	tests := []struct {
+		p, q *int32
+		eq   bool
+	}{
+		{p: nil, q: nil, eq: true},
+		{p: &one, q: &one, eq: true},
+		{p: &one, q: &one2, eq: true},
+		{p: &one, q: &two},
+		{p: &one, q: nil},
+		{p: nil, q: &one},
+	}
+	for _, tt := range tests {
+		if eq := OptionalEqual(tt.p, tt.q); eq != tt.eq {
+			t.Errorf("OptionalEqual(%v, %v) = %v, want %v", tt.p, tt.q, eq, tt.eq)
+		}
+	}
+}
+
+func TestFloatEqual(t *testing.T) {
+	nan, zero := math.NaN(), 0.0
+	tests := []struct {
+		a, b           float64
+		eq, signedZero bool
+	}{
+		{a: 1, b: 1, eq: true, signedZero: true},
+		{a: 1, b: 2},
+		{a: nan, b: nan, eq: true, signedZero: true},
+		{a: nan, b: 1},
+		{a: 0, b: math.Copysign(0, -1), eq: true},
+		{a: math.Inf(1), b: math.Inf(1), eq: true, signedZero: true},
+	}
+	for _, tt := range tests {
+		if eq := FloatEqual(tt.a, tt.b); eq != tt.eq {
+			t.Errorf("FloatEqual(%v, %v) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+		if eq := FloatEqualSignedZero(tt.a, tt.b); eq != tt.signedZero {
+			t.Errorf("FloatEqualSignedZero(%v, %v) = %v, want %v", tt.a, tt.b, eq, tt.signedZero)
+		}
+		if eq := FloatEqual(float32(tt.a), float32(tt.b)); eq != tt.eq {
+			t.Errorf("FloatEqual(float32(%v), float32(%v)) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+	}
+	if !OptionalFloatEqual(&nan, &nan) || OptionalFloatEqual(&zero, nil) || !OptionalFloatEqual[float64](nil, nil) {
+		t.Errorf("OptionalFloatEqual() does not compare presence and NaNs")
+	}
+}
+
+func TestBytesEqual(t *testing.T) {
+	tests := []struct {
+		a, b         []byte
+		eq, optional bool
+	}{
+		{a: nil, b: nil, eq: true, optional: true},
+		{a: nil, b: []byte{}, eq: true},
+		{a: []byte{}, b: []byte{}, eq: true, optional: true},
+		{a: []byte("a"), b: []byte("a"), eq: true, optional: true},
+		{a: []byte("a"), b: []byte("b")},
+	}
+	for _, tt := range tests {
+		if eq := BytesEqual(tt.a, tt.b); eq != tt.eq {
+			t.Errorf("BytesEqual(%q, %q) = %v, want %v", tt.a, tt.b, eq, tt.eq)
+		}
+		if eq := OptionalBytesEqual(tt.a, tt.b); eq != tt.optional {
+			t.Errorf("OptionalBytesEqual(%q, %q) = %v, want %v", tt.a, tt.b, eq, tt.optional)
+		}
+	}
+}
+
+func TestSliceEqual(t *testing.T) {
+	tests := []struct {
+		x, y []float64
+		eq   bool
+	}{
+		{x: nil, y: []float64{}, eq: true},
+		{x: []float64{1, 2}, y: []float64{1, 2}, eq: true},
+		{x: []float64{1, 2}, y: []float64{2, 1}},
+		{x: []float64{1}, y: []float64{1, 1}},
+	}
+	for _, tt := range tests {
+		if eq := SliceEqual(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("SliceEqual(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+		if eq := SliceEqualFunc(tt.x, tt.y, FloatEqual[float64]); eq != tt.eq {
+			t.Errorf("SliceEqualFunc(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+	}
+	if nan := math.NaN(); SliceEqual([]float64{nan}, []float64{nan}) || !SliceEqualFunc([]float64{nan}, []float64{nan}, FloatEqual[float64]) {
+		t.Errorf("SliceEqualFunc() does not use the equal function")
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	tests := []struct {
+		x, y map[string]int32
+		eq   bool
+	}{
+		{x: nil, y: map[string]int32{}, eq: true},
+		{x: map[string]int32{"a": 1, "b": 2}, y: map[string]int32{"b": 2, "a": 1}, eq: true},
+		{x: map[string]int32{"a": 1}, y: map[string]int32{"a": 2}},
+		{x: map[string]int32{"a": 0}, y: map[string]int32{"b": 0}},
+		{x: map[string]int32{"a": 0}, y: nil},
+	}
+	for _, tt := range tests {
+		if eq := MapEqual(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("MapEqual(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+		equal := func(a, b int32) bool { return a == b }
+		if eq := MapEqualFunc(tt.x, tt.y, equal); eq != tt.eq {
+			t.Errorf("MapEqualFunc(%v, %v) = %v, want %v", tt.x, tt.y, eq, tt.eq)
+		}
+	}
+}
+
+func TestValuesEqualAllocs(t *testing.T) {
+	one := int32(1)
+	s := []float64{1, 2, 3}
+	m := map[string][]byte{"a": []byte("b")}
+	allocs := testing.AllocsPerRun(100, func() {
+		OptionalEqual(&one, &one)
+		SliceEqualFunc(s, s, FloatEqual[float64])
+		MapEqualFunc(m, m, BytesEqual)
+	})
+	if allocs != 0 {
+		t.Errorf("comparing values allocates %v times, want 0", allocs)
+	}
+}
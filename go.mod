@@ -2,4 +2,7 @@ module github.com/melias122/protoc-gen-go-equal
 
 go 1.18
 
-require google.golang.org/protobuf v1.28.1
+require (
+	golang.org/x/text v0.14.0
+	google.golang.org/protobuf v1.28.1
+)
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/melias122/protoc-gen-go-equal/equal"
+)
+
+var maphashPackage = protogen.GoImportPath("hash/maphash")
+
+// genHash generates Hash methods consistent with Equal: messages that are
+// equal under the unknown and float parameters write the same bytes to h.
+func genHash(g *protogen.GeneratedFile, messages []*protogen.Message, proto3 bool) {
+	for _, m := range messages {
+
+		// Generate hash for nested messages
+		if len(m.Messages) > 0 {
+			genHash(g, m.Messages, proto3)
+		}
+
+		// Do not generate extra message for map entries
+		if m.Desc.IsMapEntry() {
+			continue
+		}
+
+		// Skip messages disabled by options
+		if !isGenerated(m.Desc) {
+			continue
+		}
+
+		g.P()
+		g.P(`func (x *`, m.GoIdent, `) Hash(h *`, maphashPackage.Ident("Hash"), `) {`)
+		g.P(`if x == nil {`)
+		g.P(`return`)
+		g.P(`}`)
+
+		for _, f := range m.Fields {
+
+			if isIgnored(f) {
+				continue
+			}
+
+			fieldName := f.GoName
+
+			switch {
+			case f.Oneof != nil && !f.Oneof.Desc.IsSynthetic():
+				// Oneof is hashed as a whole at its first field
+				if f == f.Oneof.Fields[0] {
+					genHashOneof(g, f.Oneof, proto3)
+				}
+
+			case f.Desc.IsList() && (isUnordered(f) || keyField(f) != nil):
+				// Keyed fields are hashed as multisets too, which is
+				// consistent with matching elements by key
+				g.P(equalPackage.Ident("HashUnordered"), `(h, x.`, fieldName, `, func(h *`, maphashPackage.Ident("Hash"), `, v `, goType(g, f), `) {`)
+				genHashField(g, f, `h`, `v`, proto3, true)
+				g.P(`})`)
+
+			case f.Desc.IsList() && !hashesValue(f):
+				g.P(equalPackage.Ident("HashUint64"), `(h, uint64(len(x.`, fieldName, `)))`)
+
+			case f.Desc.IsList():
+				g.P(equalPackage.Ident("HashUint64"), `(h, uint64(len(x.`, fieldName, `)))`)
+				g.P(`for i := 0; i < len(x.`, fieldName, `); i++ {`)
+				genHashField(g, f, `h`, `x.`+fieldName+`[i]`, proto3, true)
+				g.P(`}`)
+
+			case f.Desc.IsMap():
+				// Entries are hashed separately and summed, so the result
+				// does not depend on the map iteration order
+				g.P(equalPackage.Ident("HashUint64"), `(h, uint64(len(x.`, fieldName, `)))`)
+				g.P(`if len(x.`, fieldName, `) > 0 {`)
+				g.P(`var sum uint64`)
+				if hashesValue(f.Message.Fields[1]) {
+					g.P(`for k, v := range x.`, fieldName, ` {`)
+				} else {
+					g.P(`for k := range x.`, fieldName, ` {`)
+				}
+				g.P(`var e `, maphashPackage.Ident("Hash"))
+				g.P(`e.SetSeed(h.Seed())`)
+				genHashField(g, f.Message.Fields[0], `&e`, `k`, proto3, true)
+				if hashesValue(f.Message.Fields[1]) {
+					genHashField(g, f.Message.Fields[1], `&e`, `v`, proto3, true)
+				}
+				g.P(`sum += e.Sum64()`)
+				g.P(`}`)
+				g.P(equalPackage.Ident("HashUint64"), `(h, sum)`)
+				g.P(`}`)
+
+			default:
+				genHashField(g, f, `h`, `x.`+fieldName, proto3, false)
+			}
+		}
+
+		if m.Desc.ExtensionRanges().Len() > 0 {
+			g.P(equalPackage.Ident("HashExtensions"), `(h, x.ProtoReflect(), `, extensionsFloatMode(g), `)`)
+		}
+
+		switch params.unknown {
+		case unknownRaw:
+			g.P(equalPackage.Ident("HashBytes"), `(h, x.ProtoReflect().GetUnknown())`)
+		case unknownCanonical:
+			// Reordering unknown fields keeps their total length
+			g.P(equalPackage.Ident("HashUint64"), `(h, uint64(len(x.ProtoReflect().GetUnknown())))`)
+		}
+
+		g.P(`}`)
+	}
+}
+
+// genHashOneof hashes the field number of the populated oneof member
+// followed by its value.
+func genHashOneof(g *protogen.GeneratedFile, oneof *protogen.Oneof, proto3 bool) {
+	usesValue := false
+	for _, f := range oneof.Fields {
+		usesValue = usesValue || hashesValue(f)
+	}
+	if usesValue {
+		g.P(`switch v := x.`, oneof.GoName, `.(type) {`)
+	} else {
+		g.P(`switch x.`, oneof.GoName, `.(type) {`)
+	}
+	g.P(`case nil:`)
+	g.P(equalPackage.Ident("HashUint64"), `(h, 0)`)
+	for _, f := range oneof.Fields {
+		g.P(`case *`, f.GoIdent, `:`)
+		g.P(equalPackage.Ident("HashUint64"), `(h, `, strconv.Itoa(int(f.Desc.Number())), `)`)
+		if hashesValue(f) {
+			genHashField(g, f, `h`, `v.`+f.GoName, proto3, false)
+		}
+	}
+	g.P(`}`)
+}
+
+// hashesValue reports whether genHashField writes anything for a non-nullable
+// value of f. Floats with (equal.field).tolerance and bytes with
+// (equal.field).content_type are not hashed.
+func hashesValue(f *protogen.Field) bool {
+	return f.Message != nil || floatTolerance(f) == nil && contentType(f) == ""
+}
+
+// genHashField writes value x of field f to the hash h.
+func genHashField(g *protogen.GeneratedFile, f *protogen.Field, h, x string, proto3 bool, repeated bool) {
+	nullable, _ := fieldPresence(f, proto3, repeated)
+	hashBool := g.QualifiedGoIdent(equalPackage.Ident("HashBool"))
+
+	switch f.Desc.Kind() {
+	case protoreflect.BytesKind:
+		if nullable {
+			g.P(hashBool, `(`, h, `, `, x, ` != nil)`)
+		}
+		if contentType(f) != "" {
+			// Values with different bytes may be equal, only presence is hashed
+			return
+		}
+		g.P(equalPackage.Ident("HashBytes"), `(`, h, `, `, x, `)`)
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		g.P(hashBool, `(`, h, `, `, x, ` != nil)`)
+
+		switch f.Message.Location.SourceFile {
+		case "google/protobuf/any.proto":
+			g.P(`if p := `, x, `; p != nil {`)
+			if isAnyTypeName(f) {
+				g.P(equalPackage.Ident("HashString"), `(`, h, `, `, equalPackage.Ident("AnyTypeName"), `(p.TypeUrl))`)
+			} else {
+				g.P(equalPackage.Ident("HashString"), `(`, h, `, p.TypeUrl)`)
+			}
+			if params.any == anyRaw {
+				// Unpacked values equal with different bytes hash only their type URL
+				g.P(equalPackage.Ident("HashBytes"), `(`, h, `, p.Value)`)
+			}
+			g.P(`}`)
+
+		case "google/protobuf/duration.proto", "google/protobuf/timestamp.proto":
+			if _, ok := timeTolerance(f); ok {
+				// Values within tolerance are equal, only presence is hashed
+				return
+			}
+			g.P(`if p := `, x, `; p != nil {`)
+			if params.time == timeNormalized {
+				g.P(equalPackage.Ident("HashTime"), `(`, h, `, p.Seconds, p.Nanos)`)
+			} else {
+				g.P(equalPackage.Ident("HashUint64"), `(`, h, `, uint64(p.Seconds))`)
+				g.P(equalPackage.Ident("HashUint64"), `(`, h, `, uint64(p.Nanos))`)
+			}
+			g.P(`}`)
+
+		case "google/protobuf/struct.proto":
+			g.P(equalPackage.Ident("Hash"+f.Message.GoIdent.GoName), `(`, h, `, `, x, `, `, unknownMode(g), `)`)
+
+		case "google/protobuf/empty.proto":
+
+		case "google/protobuf/wrappers.proto":
+			if floatTolerance(f) != nil {
+				// Values within tolerance are equal, only presence is hashed
+				return
+			}
+			g.P(`if p := `, x, `; p != nil {`)
+			g.P(hashValue(g, f.Message.Fields[0].Desc.Kind(), h, `p.Value`))
+			g.P(`}`)
+
+		case "google/protobuf/field_mask.proto":
+			if params.fieldMask == fieldMaskNormalized {
+				g.P(equalPackage.Ident("HashFieldMask"), `(`, h, `, `, x, `, `, unknownMode(g), `)`)
+				return
+			}
+			fallthrough
+
+		default:
+			if isLocalMessage(f.Message) {
+				g.P(x, `.Hash(`, h, `)`)
+				return
+			}
+			// Foreign messages without generated Hash contribute only their presence
+			g.P(`if m, ok := interface{}(`, x, `).(interface { Hash(*`, maphashPackage.Ident("Hash"), `) }); ok {`)
+			g.P(`m.Hash(`, h, `)`)
+			g.P(`}`)
+		}
+
+	default:
+		if mode := stringMode(f); mode != equal.StringMode_STRING_MODE_EXACT {
+			hashString := stringFunc(g, mode, "HashString%s")
+			if nullable {
+				g.P(hashBool, `(`, h, `, `, x, ` != nil)`)
+				g.P(`if p := `, x, `; p != nil {`)
+				g.P(hashString, `(`, h, `, *p)`)
+				g.P(`}`)
+				return
+			}
+			g.P(hashString, `(`, h, `, `, x, `)`)
+			return
+		}
+		if floatTolerance(f) != nil {
+			// Values within tolerance are equal, only presence is hashed
+			if nullable {
+				g.P(hashBool, `(`, h, `, `, x, ` != nil)`)
+			}
+			return
+		}
+		if nullable {
+			g.P(hashBool, `(`, h, `, `, x, ` != nil)`)
+			g.P(`if p := `, x, `; p != nil {`)
+			g.P(hashValue(g, f.Desc.Kind(), h, `*p`))
+			g.P(`}`)
+			return
+		}
+		g.P(hashValue(g, f.Desc.Kind(), h, x))
+	}
+}
+
+// hashValue returns a statement writing scalar v of the given kind to h.
+func hashValue(g *protogen.GeneratedFile, kind protoreflect.Kind, h, v string) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return g.QualifiedGoIdent(equalPackage.Ident("HashBool")) + `(` + h + `, ` + v + `)`
+
+	case protoreflect.StringKind:
+		return g.QualifiedGoIdent(equalPackage.Ident("HashString")) + `(` + h + `, ` + v + `)`
+
+	case protoreflect.BytesKind:
+		return g.QualifiedGoIdent(equalPackage.Ident("HashBytes")) + `(` + h + `, ` + v + `)`
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if params.float == floatBits {
+			bits := g.QualifiedGoIdent(mathPackage.Ident("Float64bits"))
+			if kind == protoreflect.FloatKind {
+				bits = g.QualifiedGoIdent(mathPackage.Ident("Float32bits"))
+			}
+			return g.QualifiedGoIdent(equalPackage.Ident("HashUint64")) + `(` + h + `, uint64(` + bits + `(` + v + `)))`
+		}
+		return g.QualifiedGoIdent(equalPackage.Ident("HashFloat64")) + `(` + h + `, float64(` + v + `))`
+
+	default:
+		return g.QualifiedGoIdent(equalPackage.Ident("HashUint64")) + `(` + h + `, uint64(` + v + `))`
+	}
+}
@@ -5,14 +5,19 @@
 package proto2test
 
 import (
+	"hash/maphash"
 	"math"
+	"reflect"
 	"testing"
 	"time"
 
+	floatbitspb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/floatbits"
 	test3pb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/test" // "google.golang.org/protobuf/internal/testprotos/test3"
 	testpb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/test"  // "google.golang.org/protobuf/internal/testprotos/test"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -28,6 +33,21 @@ func mustAny(t *testing.T, msg proto.Message) *anypb.Any {
 	return anymsg
 }
 
+func withUnknown(m *testpb.TestAllTypes, b []byte) *testpb.TestAllTypes {
+	m.ProtoReflect().SetUnknown(b)
+	return m
+}
+
+var seed = maphash.MakeSeed()
+
+// hash returns the hash of m written by its generated Hash method.
+func hash(m interface{ Hash(*maphash.Hash) }) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	m.Hash(&h)
+	return h.Sum64()
+}
+
 func TestEqual(t *testing.T) {
 	identicalPtrPb := &testpb.TestAllTypes{MapStringString: map[string]string{"a": "b", "c": "d"}}
 
@@ -492,6 +512,73 @@ func TestEqual(t *testing.T) {
 				},
 			},
 		},
+		{
+			x: &test3pb.TestAllTypes{},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint64{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{
+					OneofString: "a",
+				},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{
+					OneofString: "b",
+				},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofNestedMessage{
+					OneofNestedMessage: &test3pb.TestAllTypes_NestedMessage{},
+				},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofNestedMessage{
+					OneofNestedMessage: &test3pb.TestAllTypes_NestedMessage{},
+				},
+			},
+			eq: true,
+		},
+
+		// Unknown fields.
+		{
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y: &testpb.TestAllTypes{},
+		}, {
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 2)),
+		}, {
+			x:  withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y:  withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			eq: true,
+		},
 
 		// Known Types
 		{
@@ -655,6 +742,207 @@ func TestEqual(t *testing.T) {
 		if eq := tt.x.Equal(tt.y); eq != tt.eq {
 			t.Errorf("Equal(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
 		}
+		if tt.eq && hash(tt.x) != hash(tt.y) {
+			t.Errorf("Hash(x) != Hash(y) for equal messages\n==== x ====\n%v==== y ====\n%v", prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if c := tt.x.Compare(tt.y); (c == 0) != tt.eq || c != -tt.y.Compare(tt.x) {
+			t.Errorf("Compare(x, y) = %v, Compare(y, x) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", c, tt.y.Compare(tt.x), tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+	}
+}
+
+func TestEqualExtensions(t *testing.T) {
+	extend := func(m *testpb.TestAllExtensions, xt protoreflect.ExtensionType, v interface{}) *testpb.TestAllExtensions {
+		proto.SetExtension(m, xt, v)
+		return m
+	}
+
+	tests := []struct {
+		x, y *testpb.TestAllExtensions
+		eq   bool
+	}{
+		{
+			x:  &testpb.TestAllExtensions{},
+			y:  &testpb.TestAllExtensions{},
+			eq: true,
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(0)),
+			y: &testpb.TestAllExtensions{},
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(2)),
+		}, {
+			x:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)),
+			y:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)),
+			eq: true,
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_ForeignInt32Extension, int32(1)),
+		}, {
+			x:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalDouble, math.NaN()),
+			y:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalDouble, math.NaN()),
+			eq: true,
+		}, {
+			x:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalDouble, math.Copysign(0, -1)),
+			y:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalDouble, float64(0)),
+			eq: true,
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalNestedMessage, &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(1)}),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalNestedMessage, &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(2)}),
+		}, {
+			x:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalNestedMessage, &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(1)}),
+			y:  extend(&testpb.TestAllExtensions{}, testpb.E_OptionalNestedMessage, &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(1)}),
+			eq: true,
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedInt32, []int32{1, 2}),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedInt32, []int32{1, 3}),
+		}, {
+			x:  extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedInt32, []int32{1, 2}),
+			y:  extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedInt32, []int32{1, 2}),
+			eq: true,
+		}, {
+			x: extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedNestedMessage, []*testpb.TestAllExtensions_NestedMessage{{A: proto.Int32(1)}}),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_RepeatedNestedMessage, []*testpb.TestAllExtensions_NestedMessage{{A: proto.Int32(2)}}),
+		}, {
+			x: extend(extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)), testpb.E_ForeignInt32Extension, int32(1)),
+			y: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)),
+		}, {
+			x:  extend(extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1)), testpb.E_ForeignInt32Extension, int32(2)),
+			y:  extend(extend(&testpb.TestAllExtensions{}, testpb.E_ForeignInt32Extension, int32(2)), testpb.E_OptionalInt32, int32(1)),
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		if !tt.eq && !tt.x.Equal(tt.x) {
+			t.Errorf("Equal(x, x) = false, want true\n==== x ====\n%v", prototext.Format(tt.x))
+		}
+		if !tt.eq && !tt.y.Equal(tt.y) {
+			t.Errorf("Equal(y, y) = false, want true\n==== y ====\n%v", prototext.Format(tt.y))
+		}
+		if eq := tt.x.Equal(tt.y); eq != tt.eq {
+			t.Errorf("Equal(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if tt.eq && hash(tt.x) != hash(tt.y) {
+			t.Errorf("Hash(x) != Hash(y) for equal messages\n==== x ====\n%v==== y ====\n%v", prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if c := tt.x.Compare(tt.y); (c == 0) != tt.eq || c != -tt.y.Compare(tt.x) {
+			t.Errorf("Compare(x, y) = %v, Compare(y, x) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", c, tt.y.Compare(tt.x), tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if d := tt.x.Diff(tt.y); (len(d) == 0) != tt.eq {
+			t.Errorf("Diff(x, y) = %v, want equal %v", d, tt.eq)
+		}
+	}
+}
+
+func TestChangedFieldsExtensions(t *testing.T) {
+	extend := func(m *testpb.TestAllExtensions, xt protoreflect.ExtensionType, v interface{}) *testpb.TestAllExtensions {
+		proto.SetExtension(m, xt, v)
+		return m
+	}
+
+	tests := []struct {
+		x, y  *testpb.TestAllExtensions_NestedMessage
+		paths []string
+	}{
+		{
+			x: &testpb.TestAllExtensions_NestedMessage{Corecursive: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1))},
+			y: &testpb.TestAllExtensions_NestedMessage{Corecursive: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1))},
+		}, {
+			// Extensions have no path, the message holding them is reported
+			x:     &testpb.TestAllExtensions_NestedMessage{Corecursive: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1))},
+			y:     &testpb.TestAllExtensions_NestedMessage{Corecursive: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(2))},
+			paths: []string{"corecursive"},
+		}, {
+			x:     &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(1), Corecursive: extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1))},
+			y:     &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(2), Corecursive: &testpb.TestAllExtensions{}},
+			paths: []string{"a", "corecursive"},
+		},
+	}
+
+	for _, tt := range tests {
+		if paths := tt.x.ChangedFields(tt.y).GetPaths(); !reflect.DeepEqual(paths, tt.paths) {
+			t.Errorf("ChangedFields(x, y) = %q, want %q\n==== x ====\n%v==== y ====\n%v", paths, tt.paths, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+	}
+
+	// Extensions of the compared messages themselves are not reported
+	x := extend(&testpb.TestAllExtensions{}, testpb.E_OptionalInt32, int32(1))
+	if paths := x.ChangedFields(&testpb.TestAllExtensions{}).GetPaths(); len(paths) != 0 {
+		t.Errorf("ChangedFields(x, y) = %q, want none", paths)
+	}
+}
+
+func TestCompareExtensionsOrder(t *testing.T) {
+	// Nested messages order like their generated Compare, not their encoding,
+	// where the varint of -1 sorts after 1.
+	var ms []*testpb.TestAllExtensions
+	for _, a := range []int32{-1, 1, 2} {
+		m := &testpb.TestAllExtensions{}
+		proto.SetExtension(m, testpb.E_OptionalNestedMessage, &testpb.TestAllExtensions_NestedMessage{A: proto.Int32(a)})
+		ms = append(ms, m)
+	}
+	for i := 0; i+1 < len(ms); i++ {
+		if c := ms[i].Compare(ms[i+1]); c >= 0 {
+			t.Errorf("Compare(x, y) = %v, want < 0\n==== x ====\n%v==== y ====\n%v", c, prototext.Format(ms[i]), prototext.Format(ms[i+1]))
+		}
+	}
+}
+
+func TestEqualExtensionsFloatBits(t *testing.T) {
+	extend := func(xt protoreflect.ExtensionType, v interface{}) *floatbitspb.ExtendableFloats {
+		m := &floatbitspb.ExtendableFloats{}
+		proto.SetExtension(m, xt, v)
+		return m
+	}
+	negZero := math.Copysign(0, -1)
+	otherNaN := math.Float64frombits(math.Float64bits(math.NaN()) ^ 1)
+
+	tests := []struct {
+		x, y *floatbitspb.ExtendableFloats
+		eq   bool
+	}{
+		{
+			x: extend(floatbitspb.E_ExtensionDouble, negZero),
+			y: extend(floatbitspb.E_ExtensionDouble, float64(0)),
+		}, {
+			x:  extend(floatbitspb.E_ExtensionDouble, negZero),
+			y:  extend(floatbitspb.E_ExtensionDouble, negZero),
+			eq: true,
+		}, {
+			x: extend(floatbitspb.E_ExtensionDouble, math.NaN()),
+			y: extend(floatbitspb.E_ExtensionDouble, otherNaN),
+		}, {
+			x:  extend(floatbitspb.E_ExtensionDouble, math.NaN()),
+			y:  extend(floatbitspb.E_ExtensionDouble, math.NaN()),
+			eq: true,
+		}, {
+			x: extend(floatbitspb.E_ExtensionFloat, float32(negZero)),
+			y: extend(floatbitspb.E_ExtensionFloat, float32(0)),
+		}, {
+			x:  extend(floatbitspb.E_ExtensionFloat, float32(1)),
+			y:  extend(floatbitspb.E_ExtensionFloat, float32(1)),
+			eq: true,
+		}, {
+			x: extend(floatbitspb.E_ExtensionRepeatedDouble, []float64{1, negZero}),
+			y: extend(floatbitspb.E_ExtensionRepeatedDouble, []float64{1, 0}),
+		}, {
+			x:  extend(floatbitspb.E_ExtensionRepeatedDouble, []float64{1, negZero}),
+			y:  extend(floatbitspb.E_ExtensionRepeatedDouble, []float64{1, negZero}),
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		if eq := tt.x.Equal(tt.y); eq != tt.eq {
+			t.Errorf("Equal(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if tt.eq && hash(tt.x) != hash(tt.y) {
+			t.Errorf("Hash(x) != Hash(y) for equal messages\n==== x ====\n%v==== y ====\n%v", prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if c := tt.x.Compare(tt.y); (c == 0) != tt.eq || c != -tt.y.Compare(tt.x) {
+			t.Errorf("Compare(x, y) = %v, Compare(y, x) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", c, tt.y.Compare(tt.x), tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
 	}
 }
 
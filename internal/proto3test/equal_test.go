@@ -5,18 +5,32 @@
 package proto3test
 
 import (
+	"hash/maphash"
 	"math"
+	"reflect"
+	"sort"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/melias122/protoc-gen-go-equal/equal"
+	anyunpackpb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/anyunpack"
+	maskspb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/fieldmask"
+	floatbitspb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/floatbits"
+	floatpb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/floatproto"
+	optionspb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/options"
 	"github.com/melias122/protoc-gen-go-equal/internal/testprotos/other"
 	test3pb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/test3" // "google.golang.org/protobuf/internal/testprotos/test3"
 	testpb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/test3"  // "google.golang.org/protobuf/internal/testprotos/test"
+	timepb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/timenormalized"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -29,6 +43,62 @@ func mustAny(t *testing.T, msg proto.Message) *anypb.Any {
 	return anymsg
 }
 
+func mustStruct(t *testing.T, v map[string]interface{}) *structpb.Struct {
+	s, err := structpb.NewStruct(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func withUnknown(m *testpb.TestAllTypes, b []byte) *testpb.TestAllTypes {
+	m.ProtoReflect().SetUnknown(b)
+	return m
+}
+
+var seed = maphash.MakeSeed()
+
+// hash returns the hash of m written by its generated Hash method.
+func hash(m interface{ Hash(*maphash.Hash) }) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	m.Hash(&h)
+	return h.Sum64()
+}
+
+// generated is a message with generated Equal, Hash and Compare methods.
+type generated[T any] interface {
+	proto.Message
+	Equal(T) bool
+	Hash(*maphash.Hash)
+	Compare(T) int
+}
+
+// checkEqual checks that the generated methods of x and y agree on whether
+// the messages are equal: Equal in both directions reports want, Diff, if
+// generated, reports no differences exactly when want, equal messages hash
+// the same and Compare is antisymmetric and returns 0 exactly when want.
+func checkEqual[T generated[T]](t *testing.T, x, y T, want bool) {
+	t.Helper()
+	if eq := x.Equal(y); eq != want {
+		t.Errorf("Equal(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, want, prototext.Format(x), prototext.Format(y))
+	}
+	if eq := y.Equal(x); eq != want {
+		t.Errorf("Equal(y, x) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, want, prototext.Format(x), prototext.Format(y))
+	}
+	if m, ok := interface{}(x).(interface{ Diff(T) []equal.Difference }); ok {
+		if d := m.Diff(y); (len(d) == 0) != want {
+			t.Errorf("Diff(x, y) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", d, want, prototext.Format(x), prototext.Format(y))
+		}
+	}
+	if want && hash(x) != hash(y) {
+		t.Errorf("Hash(x) != Hash(y) for equal messages\n==== x ====\n%v==== y ====\n%v", prototext.Format(x), prototext.Format(y))
+	}
+	if c := x.Compare(y); (c == 0) != want || c != -y.Compare(x) {
+		t.Errorf("Compare(x, y) = %v, Compare(y, x) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", c, y.Compare(x), want, prototext.Format(x), prototext.Format(y))
+	}
+}
+
 func TestEqual(t *testing.T) {
 	identicalPtrPb := &testpb.TestAllTypes{MapStringString: map[string]string{"a": "b", "c": "d"}}
 
@@ -593,6 +663,80 @@ func TestEqual(t *testing.T) {
 				},
 			},
 		},
+		{
+			x: &test3pb.TestAllTypes{},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint64{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{
+					OneofString: "a",
+				},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofString{
+					OneofString: "b",
+				},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofUint32{},
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofNestedMessage{
+					OneofNestedMessage: &test3pb.TestAllTypes_NestedMessage{},
+				},
+			},
+			y: &test3pb.TestAllTypes{
+				OneofField: &test3pb.TestAllTypes_OneofNestedMessage{
+					OneofNestedMessage: &test3pb.TestAllTypes_NestedMessage{},
+				},
+			},
+			eq: true,
+		},
+
+		// Unknown fields.
+		{
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y: &testpb.TestAllTypes{},
+		}, {
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 2)),
+		}, {
+			x:  withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y:  withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			eq: true,
+		}, {
+			// Fields with different numbers in a different order, as proto.Equal
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(
+				protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1), 50001, protowire.VarintType), 2)),
+			y: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(
+				protowire.AppendVarint(protowire.AppendTag(nil, 50001, protowire.VarintType), 2), 50000, protowire.VarintType), 1)),
+			eq: true,
+		},
 
 		// Known Types
 		{
@@ -672,6 +816,123 @@ func TestEqual(t *testing.T) {
 			},
 			eq: true,
 		},
+		{
+			x: &test3pb.TestAllTypes{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"a"}},
+			},
+			y: &test3pb.TestAllTypes{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"b"}},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				FieldMask: &fieldmaskpb.FieldMask{},
+			},
+			y: &test3pb.TestAllTypes{
+				FieldMask: nil,
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"a"}},
+			},
+			y: &test3pb.TestAllTypes{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"a"}},
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"a": 1, "b": []interface{}{"c", true, nil}}),
+			},
+			y: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"a": 1, "b": []interface{}{"c", true, nil}}),
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"a": 1, "b": []interface{}{"c", true, nil}}),
+			},
+			y: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"a": 1, "b": []interface{}{"c", false, nil}}),
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"a": 1}),
+			},
+			y: &test3pb.TestAllTypes{
+				Struct: mustStruct(t, map[string]interface{}{"b": 1}),
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Struct: &structpb.Struct{},
+			},
+			y: &test3pb.TestAllTypes{
+				Struct: nil,
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Value: structpb.NewNumberValue(math.NaN()),
+			},
+			y: &test3pb.TestAllTypes{
+				Value: structpb.NewNumberValue(math.NaN()),
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Value: structpb.NewNumberValue(math.Copysign(0, -1)),
+			},
+			y: &test3pb.TestAllTypes{
+				Value: structpb.NewNumberValue(0),
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Value: structpb.NewNumberValue(0),
+			},
+			y: &test3pb.TestAllTypes{
+				Value: structpb.NewBoolValue(false),
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Value: structpb.NewNullValue(),
+			},
+			y: &test3pb.TestAllTypes{
+				Value: &structpb.Value{},
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				Value: structpb.NewStringValue("a"),
+			},
+			y: &test3pb.TestAllTypes{
+				Value: structpb.NewStringValue("b"),
+			},
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				ListValue: &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewStructValue(&structpb.Struct{})}},
+			},
+			y: &test3pb.TestAllTypes{
+				ListValue: &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewStructValue(&structpb.Struct{})}},
+			},
+			eq: true,
+		},
+		{
+			x: &test3pb.TestAllTypes{
+				ListValue: &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1)}},
+			},
+			y: &test3pb.TestAllTypes{
+				ListValue: &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewNumberValue(2)}},
+			},
+		},
 		{
 			x: &test3pb.TestAllTypes{
 				WrappersBoolValue: wrapperspb.Bool(true),
@@ -696,6 +957,15 @@ func TestEqual(t *testing.T) {
 				WrappersDoubleValue: wrapperspb.Double(.55),
 			},
 		},
+		{
+			x: &test3pb.TestAllTypes{
+				WrappersDoubleValue: wrapperspb.Double(math.NaN()),
+			},
+			y: &test3pb.TestAllTypes{
+				WrappersDoubleValue: wrapperspb.Double(math.NaN()),
+			},
+			eq: true,
+		},
 		{
 			x: &test3pb.TestAllTypes{
 				WrappersFloatValue: wrapperspb.Float(.5),
@@ -782,6 +1052,1136 @@ func TestEqual(t *testing.T) {
 		if eq := tt.x.Equal(tt.y); eq != tt.eq {
 			t.Errorf("Equal(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
 		}
+		if tt.eq && hash(tt.x) != hash(tt.y) {
+			t.Errorf("Hash(x) != Hash(y) for equal messages\n==== x ====\n%v==== y ====\n%v", prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if c := tt.x.Compare(tt.y); (c == 0) != tt.eq || c != -tt.y.Compare(tt.x) {
+			t.Errorf("Compare(x, y) = %v, Compare(y, x) = %v, want equal %v\n==== x ====\n%v==== y ====\n%v", c, tt.y.Compare(tt.x), tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		x, y  *testpb.TestAllTypes
+		paths []string
+	}{
+		{
+			x: &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+			y: &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+		}, {
+			x:     nil,
+			y:     &testpb.TestAllTypes{},
+			paths: []string{""},
+		}, {
+			x:     &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+			y:     &testpb.TestAllTypes{SingularInt32: 2, SingularString: "b"},
+			paths: []string{"singular_int32", "singular_string"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalInt32: proto.Int32(0)},
+			y:     &testpb.TestAllTypes{},
+			paths: []string{"optional_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)}},
+			y:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(2)}},
+			paths: []string{"optional_nested_message.a"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{}},
+			y:     &testpb.TestAllTypes{},
+			paths: []string{"optional_nested_message"},
+		}, {
+			x:     &testpb.TestAllTypes{RepeatedInt32: []int32{1, 2}},
+			y:     &testpb.TestAllTypes{RepeatedInt32: []int32{1, 3}},
+			paths: []string{"repeated_int32[1]"},
+		}, {
+			x:     &testpb.TestAllTypes{RepeatedInt32: []int32{1, 2}},
+			y:     &testpb.TestAllTypes{RepeatedInt32: []int32{1}},
+			paths: []string{"repeated_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{RepeatedNestedMessage: []*testpb.TestAllTypes_NestedMessage{{A: proto.Int32(1)}}},
+			y:     &testpb.TestAllTypes{RepeatedNestedMessage: []*testpb.TestAllTypes_NestedMessage{{A: proto.Int32(2)}}},
+			paths: []string{"repeated_nested_message[0].a"},
+		}, {
+			x:     &testpb.TestAllTypes{MapStringString: map[string]string{"a": "b"}},
+			y:     &testpb.TestAllTypes{MapStringString: map[string]string{"a": "c"}},
+			paths: []string{`map_string_string["a"]`},
+		}, {
+			x:     &testpb.TestAllTypes{MapInt32Int32: map[int32]int32{1: 1}},
+			y:     &testpb.TestAllTypes{MapInt32Int32: map[int32]int32{2: 1}},
+			paths: []string{"map_int32_int32[1]", "map_int32_int32[2]"},
+		}, {
+			x:     &testpb.TestAllTypes{MapStringNestedMessage: map[string]*testpb.TestAllTypes_NestedMessage{"a": {A: proto.Int32(1)}}},
+			y:     &testpb.TestAllTypes{MapStringNestedMessage: map[string]*testpb.TestAllTypes_NestedMessage{"a": {A: proto.Int32(2)}}},
+			paths: []string{`map_string_nested_message["a"].a`},
+		}, {
+			x:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofUint32{}},
+			y:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofString{}},
+			paths: []string{"oneof_field"},
+		}, {
+			x:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofString{OneofString: "a"}},
+			y:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofString{OneofString: "b"}},
+			paths: []string{"oneof_string"},
+		}, {
+			x:     &testpb.TestAllTypes{Timestamp: timestamppb.New(time.Unix(1, 0))},
+			y:     &testpb.TestAllTypes{Timestamp: timestamppb.New(time.Unix(2, 0))},
+			paths: []string{"timestamp"},
+		}, {
+			x:     &testpb.TestAllTypes{OtherMessage: &other.OtherMessage{I: 1}},
+			y:     &testpb.TestAllTypes{OtherMessage: &other.OtherMessage{I: 2}},
+			paths: []string{"other_message.i"},
+		}, {
+			x:     withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y:     &testpb.TestAllTypes{},
+			paths: []string{"<unknown>"},
+		},
+	}
+
+	for _, tt := range tests {
+		var paths []string
+		for _, d := range tt.x.Diff(tt.y) {
+			paths = append(paths, d.Path)
+		}
+		sort.Strings(paths)
+		if !reflect.DeepEqual(paths, tt.paths) {
+			t.Errorf("Diff(x, y) paths = %q, want %q\n==== x ====\n%v==== y ====\n%v", paths, tt.paths, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if eq := tt.x.Equal(tt.y); eq != (len(paths) == 0) {
+			t.Errorf("Equal(x, y) = %v, but Diff(x, y) paths = %q", eq, paths)
+		}
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	x := &testpb.TestAllTypes{OptionalInt32: proto.Int32(1), SingularString: "a"}
+	y := &testpb.TestAllTypes{SingularString: "b"}
+
+	var got []string
+	for _, d := range x.Diff(y) {
+		got = append(got, d.String())
+	}
+	want := []string{`singular_string: "a" != "b"`, `optional_int32: 1 != <nil>`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff(x, y) = %q, want %q", got, want)
+	}
+}
+
+// TestHash checks that Hash does not depend on map iteration order and
+// distinguishes some messages which are not equal.
+func TestHash(t *testing.T) {
+	x := &testpb.TestAllTypes{
+		MapStringNestedMessage: map[string]*testpb.TestAllTypes_NestedMessage{},
+		MapInt32Double:         map[int32]float64{},
+	}
+	for i := int32(0); i < 100; i++ {
+		x.MapStringNestedMessage[strconv.Itoa(int(i))] = &testpb.TestAllTypes_NestedMessage{A: proto.Int32(i)}
+		x.MapInt32Double[i] = float64(i)
+	}
+	want := hash(x)
+	for i := 0; i < 10; i++ {
+		if got := hash(proto.Clone(x).(*testpb.TestAllTypes)); got != want {
+			t.Fatalf("Hash(clone) = %x, want %x", got, want)
+		}
+	}
+
+	messages := []*testpb.TestAllTypes{
+		nil,
+		{SingularInt32: 1},
+		{SingularInt64: 1},
+		{SingularString: "a", SingularBytes: []byte("b")},
+		{SingularString: "ab"},
+		{SingularNestedMessage: &testpb.TestAllTypes_NestedMessage{}},
+		{RepeatedInt32: []int32{1}},
+		{RepeatedInt32: []int32{1, 1}},
+		{MapInt32Int32: map[int32]int32{1: 2}},
+		{MapInt32Int32: map[int32]int32{2: 1}},
+		{OneofField: &testpb.TestAllTypes_OneofUint32{}},
+		{OneofField: &testpb.TestAllTypes_OneofString{}},
+	}
+	for i, x := range messages {
+		for j, y := range messages[i+1:] {
+			if hash(x) == hash(y) {
+				t.Errorf("Hash(messages[%d]) == Hash(messages[%d])", i, i+1+j)
+			}
+		}
+	}
+}
+
+// TestCompare checks the order defined by Compare on messages listed in
+// ascending order.
+func TestCompare(t *testing.T) {
+	messages := []*testpb.TestAllTypes{
+		nil,
+		{SingularInt32: -1},
+		{},
+		{SingularInt32: 1, SingularDouble: math.NaN()},
+		{SingularInt32: 1, SingularDouble: -1},
+		{SingularInt32: 1},
+		{SingularInt32: 1, SingularString: "a"},
+		{SingularInt32: 1, SingularString: "b"},
+		{SingularInt32: 1, SingularString: "b", SingularNestedMessage: &testpb.TestAllTypes_NestedMessage{}},
+		{SingularInt32: 1, SingularString: "b", SingularNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)}},
+		{SingularInt32: 2, RepeatedInt32: []int32{1}},
+		{SingularInt32: 2, RepeatedInt32: []int32{1, 2}},
+		{SingularInt32: 2, RepeatedInt32: []int32{2}},
+		{SingularInt32: 3, MapInt32Int32: map[int32]int32{1: 1, 2: 2}},
+		{SingularInt32: 3, MapInt32Int32: map[int32]int32{1: 1}},
+		{SingularInt32: 3, MapInt32Int32: map[int32]int32{1: 2}},
+		{SingularInt32: 3, MapInt32Int32: map[int32]int32{2: 1}},
+		{SingularInt32: 4, OneofField: &testpb.TestAllTypes_OneofUint32{OneofUint32: 2}},
+		{SingularInt32: 4, OneofField: &testpb.TestAllTypes_OneofUint32{OneofUint32: 3}},
+		{SingularInt32: 4, OneofField: &testpb.TestAllTypes_OneofNestedMessage{}},
+		{SingularInt32: 4, OneofField: &testpb.TestAllTypes_OneofString{}},
+	}
+
+	for i, x := range messages {
+		for j, y := range messages {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if c := x.Compare(y); c != want {
+				t.Errorf("messages[%d].Compare(messages[%d]) = %v, want %v", i, j, c, want)
+			}
+		}
+	}
+}
+
+// TestChangedFields checks the FieldMask paths returned by ChangedFields.
+func TestChangedFields(t *testing.T) {
+	tests := []struct {
+		x, y  *testpb.TestAllTypes
+		paths []string
+	}{
+		{
+			x: &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+			y: &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+		}, {
+			x: nil,
+			y: &testpb.TestAllTypes{},
+		}, {
+			x:     nil,
+			y:     &testpb.TestAllTypes{SingularInt32: 1},
+			paths: []string{"singular_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{SingularInt32: 1, SingularString: "a"},
+			y:     &testpb.TestAllTypes{SingularInt32: 2, SingularString: "b"},
+			paths: []string{"singular_int32", "singular_string"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalInt32: proto.Int32(0)},
+			y:     &testpb.TestAllTypes{},
+			paths: []string{"optional_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)}},
+			y:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(2)}},
+			paths: []string{"optional_nested_message.a"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{Corecursive: &testpb.TestAllTypes{SingularInt64: 1}}},
+			y:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{Corecursive: &testpb.TestAllTypes{}}},
+			paths: []string{"optional_nested_message.corecursive.singular_int64"},
+		}, {
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{}},
+			y:     &testpb.TestAllTypes{},
+			paths: []string{"optional_nested_message"},
+		}, {
+			x:     &testpb.TestAllTypes{RepeatedInt32: []int32{1, 2}},
+			y:     &testpb.TestAllTypes{RepeatedInt32: []int32{1, 3}},
+			paths: []string{"repeated_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{RepeatedNestedMessage: []*testpb.TestAllTypes_NestedMessage{{A: proto.Int32(1)}}},
+			y:     &testpb.TestAllTypes{RepeatedNestedMessage: []*testpb.TestAllTypes_NestedMessage{{A: proto.Int32(2)}}},
+			paths: []string{"repeated_nested_message"},
+		}, {
+			x:     &testpb.TestAllTypes{MapStringNestedMessage: map[string]*testpb.TestAllTypes_NestedMessage{"a": {A: proto.Int32(1)}}},
+			y:     &testpb.TestAllTypes{MapStringNestedMessage: map[string]*testpb.TestAllTypes_NestedMessage{"a": {A: proto.Int32(2)}}},
+			paths: []string{"map_string_nested_message"},
+		}, {
+			x:     &testpb.TestAllTypes{MapInt32Int32: map[int32]int32{1: 1}},
+			y:     &testpb.TestAllTypes{MapInt32Int32: map[int32]int32{2: 1}},
+			paths: []string{"map_int32_int32"},
+		}, {
+			x:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofUint32{}},
+			y:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofString{}},
+			paths: []string{"oneof_string", "oneof_uint32"},
+		}, {
+			x:     &testpb.TestAllTypes{},
+			y:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofString{}},
+			paths: []string{"oneof_string"},
+		}, {
+			x:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofNestedMessage{OneofNestedMessage: &testpb.TestAllTypes_NestedMessage{}}},
+			y:     &testpb.TestAllTypes{OneofField: &testpb.TestAllTypes_OneofNestedMessage{OneofNestedMessage: &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)}}},
+			paths: []string{"oneof_nested_message.a"},
+		}, {
+			x:     &testpb.TestAllTypes{Timestamp: timestamppb.New(time.Unix(1, 0))},
+			y:     &testpb.TestAllTypes{Timestamp: timestamppb.New(time.Unix(2, 0))},
+			paths: []string{"timestamp"},
+		}, {
+			x:     &testpb.TestAllTypes{OtherMessage: &other.OtherMessage{I: 1}},
+			y:     &testpb.TestAllTypes{OtherMessage: &other.OtherMessage{I: 2}},
+			paths: []string{"other_message.i"},
+		}, {
+			// Unknown fields have no path, the message holding them is reported
+			x:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{Corecursive: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1))}},
+			y:     &testpb.TestAllTypes{OptionalNestedMessage: &testpb.TestAllTypes_NestedMessage{Corecursive: &testpb.TestAllTypes{}}},
+			paths: []string{"optional_nested_message.corecursive"},
+		}, {
+			// Unknown fields of the compared messages themselves are not reported
+			x: withUnknown(&testpb.TestAllTypes{}, protowire.AppendVarint(protowire.AppendTag(nil, 50000, protowire.VarintType), 1)),
+			y: &testpb.TestAllTypes{},
+		},
+	}
+
+	for _, tt := range tests {
+		mask := tt.x.ChangedFields(tt.y)
+		paths := append([]string(nil), mask.GetPaths()...)
+		sort.Strings(paths)
+		if !reflect.DeepEqual(paths, tt.paths) {
+			t.Errorf("ChangedFields(x, y) = %q, want %q\n==== x ====\n%v==== y ====\n%v", paths, tt.paths, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+		if !mask.IsValid(&testpb.TestAllTypes{}) {
+			t.Errorf("ChangedFields(x, y) = %q, not valid for %T", paths, tt.x)
+		}
+	}
+}
+
+// TestMessages checks that equal.Messages uses the generated Equal methods
+// registered by generated files.
+func TestMessages(t *testing.T) {
+	tests := []struct {
+		x, y proto.Message
+		eq   bool
+	}{
+		{
+			// Etag is ignored by the generated Equal, but not by proto.Equal
+			x:  &optionspb.Resource{Id: "a", Etag: "1"},
+			y:  &optionspb.Resource{Id: "a", Etag: "2"},
+			eq: true,
+		}, {
+			x: &optionspb.Resource{Id: "a"},
+			y: &optionspb.Resource{Id: "b"},
+		}, {
+			x:  &testpb.TestAllTypes{SingularDouble: math.NaN()},
+			y:  &testpb.TestAllTypes{SingularDouble: math.NaN()},
+			eq: true,
+		}, {
+			x: &optionspb.Resource{Id: "a"},
+			y: &optionspb.Sets{},
+		}, {
+			x:  &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)},
+			y:  &testpb.TestAllTypes_NestedMessage{A: proto.Int32(1)},
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		if eq := equal.Messages(tt.x, tt.y); eq != tt.eq {
+			t.Errorf("Messages(x, y) = %v, want %v\n==== x ====\n%v==== y ====\n%v", eq, tt.eq, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+	}
+}
+
+// TestEqualIgnore checks that fields with (equal.field).ignore are excluded
+// from the generated methods.
+func TestEqualIgnore(t *testing.T) {
+	tests := []struct {
+		x, y *optionspb.Resource
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Resource{Id: "a", Etag: "1", LastSeenAt: 1},
+			y:  &optionspb.Resource{Id: "a", Etag: "2", LastSeenAt: 2},
+			eq: true,
+		}, {
+			x:  &optionspb.Resource{Id: "a", Labels: map[string]string{"k": "v"}, Parent: &optionspb.Resource{}},
+			y:  &optionspb.Resource{Id: "a"},
+			eq: true,
+		}, {
+			x:  &optionspb.Resource{Id: "a", State: &optionspb.Resource_Active{Active: "now"}},
+			y:  &optionspb.Resource{Id: "a", State: &optionspb.Resource_Deleted{Deleted: "now"}},
+			eq: true,
+		}, {
+			x: &optionspb.Resource{Id: "a", Etag: "1"},
+			y: &optionspb.Resource{Id: "b", Etag: "1"},
+		}, {
+			x: &optionspb.Resource{Id: "a", Tags: []string{"x"}},
+			y: &optionspb.Resource{Id: "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualMessageOptions checks that methods are generated only for messages
+// enabled by (equal.file) and (equal.message) options.
+func TestEqualMessageOptions(t *testing.T) {
+	for _, m := range []interface{}{&optionspb.Disabled{}, &optionspb.Enabled_Excluded{}} {
+		if _, ok := m.(interface{ Hash(*maphash.Hash) }); ok {
+			t.Errorf("%T has generated methods, want none", m)
+		}
+	}
+	if _, ok := interface{}(&optionspb.Enabled_Nested{}).(interface{ Hash(*maphash.Hash) }); !ok {
+		t.Errorf("%T has no generated methods", &optionspb.Enabled_Nested{})
+	}
+
+	tests := []struct {
+		x, y *optionspb.Enabled
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Enabled{Disabled: &optionspb.Disabled{Value: 1}, Excluded: &optionspb.Enabled_Excluded{Value: 1}},
+			y:  &optionspb.Enabled{Disabled: &optionspb.Disabled{Value: 1}, Excluded: &optionspb.Enabled_Excluded{Value: 1}},
+			eq: true,
+		}, {
+			x: &optionspb.Enabled{Disabled: &optionspb.Disabled{Value: 1}},
+			y: &optionspb.Enabled{Disabled: &optionspb.Disabled{Value: 2}},
+		}, {
+			x: &optionspb.Enabled{Excluded: &optionspb.Enabled_Excluded{Value: 1}},
+			y: &optionspb.Enabled{Excluded: &optionspb.Enabled_Excluded{}},
+		}, {
+			x: &optionspb.Enabled{Nested: &optionspb.Enabled_Nested{Value: 1}},
+			y: &optionspb.Enabled{Nested: &optionspb.Enabled_Nested{}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualUnordered checks that repeated fields with (equal.field).unordered
+// are compared as multisets.
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		x, y *optionspb.Sets
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Sets{Ints: []int32{1, 2, 2, 3}, Tags: []string{"a", "b"}},
+			y:  &optionspb.Sets{Ints: []int32{2, 3, 2, 1}, Tags: []string{"b", "a"}},
+			eq: true,
+		}, {
+			x: &optionspb.Sets{Ints: []int32{1, 2, 2}},
+			y: &optionspb.Sets{Ints: []int32{1, 1, 2}},
+		}, {
+			x: &optionspb.Sets{Ints: []int32{1, 2}},
+			y: &optionspb.Sets{Ints: []int32{1, 2, 2}},
+		}, {
+			x:  &optionspb.Sets{Blobs: [][]byte{[]byte("a"), nil}},
+			y:  &optionspb.Sets{Blobs: [][]byte{{}, []byte("a")}},
+			eq: true,
+		}, {
+			x:  &optionspb.Sets{Permissions: []optionspb.Sets_Permission{optionspb.Sets_PERMISSION_READ, optionspb.Sets_PERMISSION_WRITE}},
+			y:  &optionspb.Sets{Permissions: []optionspb.Sets_Permission{optionspb.Sets_PERMISSION_WRITE, optionspb.Sets_PERMISSION_READ}},
+			eq: true,
+		}, {
+			x:  &optionspb.Sets{Items: []*optionspb.Sets_Item{{Name: "a"}, {Name: "b"}, nil}},
+			y:  &optionspb.Sets{Items: []*optionspb.Sets_Item{nil, {Name: "b"}, {Name: "a"}}},
+			eq: true,
+		}, {
+			x: &optionspb.Sets{Items: []*optionspb.Sets_Item{{Name: "a"}, {Name: "b"}}},
+			y: &optionspb.Sets{Items: []*optionspb.Sets_Item{{Name: "a"}, {Name: "a"}}},
+		}, {
+			x:  &optionspb.Sets{Values: []float64{math.NaN(), 1, math.Copysign(0, -1)}},
+			y:  &optionspb.Sets{Values: []float64{0, 1, math.NaN()}},
+			eq: true,
+		}, {
+			x: &optionspb.Sets{Ordered: []string{"a", "b"}},
+			y: &optionspb.Sets{Ordered: []string{"b", "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+
+	x := &optionspb.Sets{Ints: []int32{1, 2, 3}, Items: []*optionspb.Sets_Item{{Name: "a"}, {Name: "b"}}}
+	y := proto.Clone(x).(*optionspb.Sets)
+	if n := testing.AllocsPerRun(100, func() { x.Equal(y) }); n != 0 {
+		t.Errorf("Equal of fields in the same order allocates %v times, want 0", n)
+	}
+}
+
+// TestEqualKeyed checks that elements of repeated fields with
+// (equal.field).key are matched by key.
+func TestEqualKeyed(t *testing.T) {
+	item := func(id string, count int32) *optionspb.Inventory_Item {
+		return &optionspb.Inventory_Item{Id: id, Count: count}
+	}
+
+	tests := []struct {
+		x, y  *optionspb.Inventory
+		eq    bool
+		paths []string
+	}{
+		{
+			x:  &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("b", 2)}},
+			y:  &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("b", 2), item("a", 1)}},
+			eq: true,
+		}, {
+			x:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("b", 2)}},
+			y:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("b", 3), item("a", 1)}},
+			paths: []string{`items["b"].count`},
+		}, {
+			x:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("b", 2)}},
+			y:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("c", 2), item("a", 1)}},
+			paths: []string{`items["b"]`, `items["c"]`},
+		}, {
+			x:  &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("b", 1), item("a", 2)}},
+			y:  &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("b", 1), item("a", 1), item("a", 2)}},
+			eq: true,
+		}, {
+			x:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("a", 2)}},
+			y:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 2), item("a", 1)}},
+			paths: []string{`items["a"].count`, `items["a"][1].count`},
+		}, {
+			x:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("a", 1)}},
+			y:     &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1)}},
+			paths: []string{`items["a"][1]`},
+		}, {
+			x:  &optionspb.Inventory{Flags: []*optionspb.Inventory_Flag{{On: true, Name: "x"}, {Name: "y"}}},
+			y:  &optionspb.Inventory{Flags: []*optionspb.Inventory_Flag{{Name: "y"}, {On: true, Name: "x"}}},
+			eq: true,
+		}, {
+			x:  &optionspb.Inventory{Blobs: []*optionspb.Inventory_Blob{{Digest: []byte{1}}, {Digest: []byte{2}}}},
+			y:  &optionspb.Inventory{Blobs: []*optionspb.Inventory_Blob{{Digest: []byte{2}}, {Digest: []byte{1}}}},
+			eq: true,
+		}, {
+			x:     &optionspb.Inventory{ByPermission: []*optionspb.Inventory_Blob{{Permission: optionspb.Sets_PERMISSION_READ, Digest: []byte{1}}}},
+			y:     &optionspb.Inventory{ByPermission: []*optionspb.Inventory_Blob{{Permission: optionspb.Sets_PERMISSION_READ, Digest: []byte{2}}}},
+			paths: []string{`by_permission[PERMISSION_READ].digest`},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+		var paths []string
+		for _, d := range tt.x.Diff(tt.y) {
+			paths = append(paths, d.Path)
+		}
+		if !reflect.DeepEqual(paths, tt.paths) {
+			t.Errorf("Diff(x, y) paths = %q, want %q\n==== x ====\n%v==== y ====\n%v", paths, tt.paths, prototext.Format(tt.x), prototext.Format(tt.y))
+		}
+	}
+
+	x := &optionspb.Inventory{Items: []*optionspb.Inventory_Item{item("a", 1), item("b", 2)}}
+	y := proto.Clone(x).(*optionspb.Inventory)
+	if n := testing.AllocsPerRun(100, func() { x.Equal(y) }); n != 0 {
+		t.Errorf("Equal of fields in the same order allocates %v times, want 0", n)
+	}
+}
+
+// TestEqualTolerance checks that float fields with (equal.field).tolerance
+// are equal when they differ within the tolerance.
+func TestEqualTolerance(t *testing.T) {
+	next32 := func(v float32, n int) float32 {
+		for i := 0; i < n; i++ {
+			v = math.Nextafter32(v, float32(math.Inf(1)))
+		}
+		return v
+	}
+
+	tests := []struct {
+		x, y *optionspb.Telemetry
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Telemetry{Absolute: 1},
+			y:  &optionspb.Telemetry{Absolute: 1.0005},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Absolute: 1},
+			y: &optionspb.Telemetry{Absolute: 1.002},
+		}, {
+			x:  &optionspb.Telemetry{Absolute: math.NaN()},
+			y:  &optionspb.Telemetry{Absolute: math.NaN()},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Absolute: math.NaN()},
+			y: &optionspb.Telemetry{Absolute: 0},
+		}, {
+			x: &optionspb.Telemetry{Relative: []float64{math.Inf(1)}},
+			y: &optionspb.Telemetry{Relative: []float64{1}},
+		}, {
+			x:  &optionspb.Telemetry{Relative: []float64{math.Inf(-1)}},
+			y:  &optionspb.Telemetry{Relative: []float64{math.Inf(-1)}},
+			eq: true,
+		}, {
+			x:  &optionspb.Telemetry{Ulps: proto.Float32(1)},
+			y:  &optionspb.Telemetry{Ulps: proto.Float32(next32(1, 4))},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Ulps: proto.Float32(1)},
+			y: &optionspb.Telemetry{Ulps: proto.Float32(next32(1, 5))},
+		}, {
+			x: &optionspb.Telemetry{Ulps: proto.Float32(1)},
+			y: &optionspb.Telemetry{},
+		}, {
+			x:  &optionspb.Telemetry{Relative: []float64{1e12, 1}},
+			y:  &optionspb.Telemetry{Relative: []float64{1e12 + 1, 1}},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Relative: []float64{1e12, 1}},
+			y: &optionspb.Telemetry{Relative: []float64{1e12, 1.00001}},
+		}, {
+			x:  &optionspb.Telemetry{Values: map[string]float64{"a": 1}},
+			y:  &optionspb.Telemetry{Values: map[string]float64{"a": 1.4}},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Values: map[string]float64{"a": 1}},
+			y: &optionspb.Telemetry{Values: map[string]float64{"b": 1}},
+		}, {
+			x:  &optionspb.Telemetry{Wrapped: wrapperspb.Double(1)},
+			y:  &optionspb.Telemetry{Wrapped: wrapperspb.Double(1.05)},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Wrapped: wrapperspb.Double(1)},
+			y: &optionspb.Telemetry{Wrapped: wrapperspb.Double(1.5)},
+		}, {
+			x: &optionspb.Telemetry{Exact: 1},
+			y: &optionspb.Telemetry{Exact: 1.0000001},
+		}, {
+			x:  &optionspb.Telemetry{Reading: &optionspb.Telemetry_Celsius{Celsius: 20}},
+			y:  &optionspb.Telemetry{Reading: &optionspb.Telemetry_Celsius{Celsius: 20.005}},
+			eq: true,
+		}, {
+			x: &optionspb.Telemetry{Reading: &optionspb.Telemetry_Celsius{Celsius: 20}},
+			y: &optionspb.Telemetry{Reading: &optionspb.Telemetry_Fahrenheit{Fahrenheit: 20}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualTimeTolerance checks that Timestamp and Duration fields with
+// (equal.field).time_tolerance are equal when they differ within the tolerance.
+func TestEqualTimeTolerance(t *testing.T) {
+	start := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	tests := []struct {
+		x, y *optionspb.Schedule
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Schedule{Start: timestamppb.New(start)},
+			y:  &optionspb.Schedule{Start: timestamppb.New(start.Add(time.Millisecond))},
+			eq: true,
+		}, {
+			x: &optionspb.Schedule{Start: timestamppb.New(start)},
+			y: &optionspb.Schedule{Start: timestamppb.New(start.Add(-time.Millisecond - 1))},
+		}, {
+			x:  &optionspb.Schedule{Start: &timestamppb.Timestamp{Seconds: 1, Nanos: 0}},
+			y:  &optionspb.Schedule{Start: &timestamppb.Timestamp{Seconds: 0, Nanos: 1e9}},
+			eq: true,
+		}, {
+			x: &optionspb.Schedule{Start: timestamppb.New(start)},
+			y: &optionspb.Schedule{},
+		}, {
+			x:  &optionspb.Schedule{Steps: []*durationpb.Duration{durationpb.New(time.Minute), durationpb.New(0)}},
+			y:  &optionspb.Schedule{Steps: []*durationpb.Duration{durationpb.New(time.Minute + time.Second), durationpb.New(-time.Second)}},
+			eq: true,
+		}, {
+			x: &optionspb.Schedule{Steps: []*durationpb.Duration{durationpb.New(time.Minute)}},
+			y: &optionspb.Schedule{Steps: []*durationpb.Duration{durationpb.New(time.Minute + 2*time.Second)}},
+		}, {
+			x:  &optionspb.Schedule{Deadlines: map[string]*timestamppb.Timestamp{"a": timestamppb.New(start)}},
+			y:  &optionspb.Schedule{Deadlines: map[string]*timestamppb.Timestamp{"a": timestamppb.New(start.Add(30 * time.Second))}},
+			eq: true,
+		}, {
+			x: &optionspb.Schedule{Deadlines: map[string]*timestamppb.Timestamp{"a": timestamppb.New(start)}},
+			y: &optionspb.Schedule{Deadlines: map[string]*timestamppb.Timestamp{"a": timestamppb.New(start.Add(time.Hour))}},
+		}, {
+			x: &optionspb.Schedule{Created: timestamppb.New(start)},
+			y: &optionspb.Schedule{Created: timestamppb.New(start.Add(1))},
+		}, {
+			x:  &optionspb.Schedule{End: &optionspb.Schedule_Until{Until: timestamppb.New(start)}},
+			y:  &optionspb.Schedule{End: &optionspb.Schedule_Until{Until: timestamppb.New(start.Add(time.Microsecond))}},
+			eq: true,
+		}, {
+			x: &optionspb.Schedule{End: &optionspb.Schedule_Timeout{Timeout: durationpb.New(time.Second)}},
+			y: &optionspb.Schedule{End: &optionspb.Schedule_Timeout{Timeout: durationpb.New(time.Second + 1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualTimeNormalized checks that code generated with time=normalized
+// compares Timestamp and Duration values by the instant or duration they
+// represent.
+func TestEqualTimeNormalized(t *testing.T) {
+	tests := []struct {
+		x, y *timepb.Times
+		eq   bool
+	}{
+		{
+			x:  &timepb.Times{Timestamp: &timestamppb.Timestamp{Seconds: 2, Nanos: 0}},
+			y:  &timepb.Times{Timestamp: &timestamppb.Timestamp{Seconds: 1, Nanos: 1e9}},
+			eq: true,
+		}, {
+			x: &timepb.Times{Timestamp: &timestamppb.Timestamp{Seconds: 2, Nanos: 1}},
+			y: &timepb.Times{Timestamp: &timestamppb.Timestamp{Seconds: 1, Nanos: 1e9}},
+		}, {
+			x:  &timepb.Times{Duration: &durationpb.Duration{Seconds: -1, Nanos: -500000000}},
+			y:  &timepb.Times{Duration: &durationpb.Duration{Seconds: -2, Nanos: 500000000}},
+			eq: true,
+		}, {
+			x: &timepb.Times{Duration: &durationpb.Duration{}},
+			y: &timepb.Times{},
+		}, {
+			x:  &timepb.Times{RepeatedTimestamp: []*timestamppb.Timestamp{{Seconds: 1}, {Seconds: 3, Nanos: -1e9}}},
+			y:  &timepb.Times{RepeatedTimestamp: []*timestamppb.Timestamp{{Nanos: 1e9}, {Seconds: 2}}},
+			eq: true,
+		}, {
+			x:  &timepb.Times{MapInt32Duration: map[int32]*durationpb.Duration{1: {Seconds: 1, Nanos: -1}}},
+			y:  &timepb.Times{MapInt32Duration: map[int32]*durationpb.Duration{1: {Nanos: 999999999}}},
+			eq: true,
+		}, {
+			x:  &timepb.Times{OneofField: &timepb.Times_OneofTimestamp{OneofTimestamp: &timestamppb.Timestamp{Seconds: 1}}},
+			y:  &timepb.Times{OneofField: &timepb.Times_OneofTimestamp{OneofTimestamp: &timestamppb.Timestamp{Nanos: 1e9}}},
+			eq: true,
+		}, {
+			x: &timepb.Times{OneofField: &timepb.Times_OneofTimestamp{OneofTimestamp: &timestamppb.Timestamp{Seconds: 1}}},
+			y: &timepb.Times{OneofField: &timepb.Times_OneofDuration{OneofDuration: &durationpb.Duration{Seconds: 1}}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// withTypeURL returns a copy of m with type URL url.
+func withTypeURL(m *anypb.Any, url string) *anypb.Any {
+	return &anypb.Any{TypeUrl: url, Value: m.Value}
+}
+
+// TestEqualTypeURL checks that Any fields with (equal.field).type_url =
+// TYPE_URL_NAME ignore the prefixes of type URLs.
+func TestEqualTypeURL(t *testing.T) {
+	value, err := anypb.New(wrapperspb.String("v"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := withTypeURL(value, "example.com/types/google.protobuf.StringValue")
+	bare := withTypeURL(value, "google.protobuf.StringValue")
+	other := withTypeURL(value, "type.googleapis.com/google.protobuf.BytesValue")
+
+	tests := []struct {
+		x, y *optionspb.Envelope
+		eq   bool
+	}{
+		{
+			x: &optionspb.Envelope{Exact: value},
+			y: &optionspb.Envelope{Exact: custom},
+		}, {
+			x:  &optionspb.Envelope{ByName: value},
+			y:  &optionspb.Envelope{ByName: custom},
+			eq: true,
+		}, {
+			x:  &optionspb.Envelope{ByName: value},
+			y:  &optionspb.Envelope{ByName: bare},
+			eq: true,
+		}, {
+			x: &optionspb.Envelope{ByName: value},
+			y: &optionspb.Envelope{ByName: other},
+		}, {
+			x: &optionspb.Envelope{ByName: value},
+			y: &optionspb.Envelope{ByName: withTypeURL(&anypb.Any{Value: []byte("w")}, custom.TypeUrl)},
+		}, {
+			x:  &optionspb.Envelope{RepeatedByName: []*anypb.Any{value, bare}},
+			y:  &optionspb.Envelope{RepeatedByName: []*anypb.Any{custom, value}},
+			eq: true,
+		}, {
+			x:  &optionspb.Envelope{MapByName: map[string]*anypb.Any{"a": value}},
+			y:  &optionspb.Envelope{MapByName: map[string]*anypb.Any{"a": custom}},
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualAnyUnpack checks that code generated with any=unpack compares
+// the messages held by Any values instead of their encoding.
+func TestEqualAnyUnpack(t *testing.T) {
+	// payload encodes the map entries in the given order followed by name,
+	// unlike proto.Marshal
+	payload := func(name string, keys ...string) *anypb.Any {
+		var b []byte
+		for _, k := range keys {
+			var entry []byte
+			entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+			entry = protowire.AppendString(entry, k)
+			entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+			entry = protowire.AppendVarint(entry, uint64(len(k)))
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendBytes(b, entry)
+		}
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, name)
+		return &anypb.Any{TypeUrl: "type.googleapis.com/goproto.proto.anyunpack.Payload", Value: b}
+	}
+	marshaled, err := anypb.New(&anyunpackpb.Payload{Name: "p", Counts: map[string]int32{"a": 1, "bb": 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown := func(b ...byte) *anypb.Any {
+		return &anypb.Any{TypeUrl: "example.com/Unknown", Value: b}
+	}
+
+	tests := []struct {
+		x, y *anyunpackpb.Anys
+		eq   bool
+	}{
+		{
+			x:  &anyunpackpb.Anys{Any: payload("p", "a", "bb")},
+			y:  &anyunpackpb.Anys{Any: payload("p", "bb", "a")},
+			eq: true,
+		}, {
+			x:  &anyunpackpb.Anys{Any: payload("p", "a", "bb")},
+			y:  &anyunpackpb.Anys{Any: marshaled},
+			eq: true,
+		}, {
+			x: &anyunpackpb.Anys{Any: payload("p", "a", "bb")},
+			y: &anyunpackpb.Anys{Any: payload("q", "a", "bb")},
+		}, {
+			x: &anyunpackpb.Anys{Any: payload("p")},
+			y: &anyunpackpb.Anys{},
+		}, {
+			x:  &anyunpackpb.Anys{Any: unknown(1)},
+			y:  &anyunpackpb.Anys{Any: unknown(1)},
+			eq: true,
+		}, {
+			x: &anyunpackpb.Anys{Any: unknown(1)},
+			y: &anyunpackpb.Anys{Any: unknown(2)},
+		}, {
+			x:  &anyunpackpb.Anys{RepeatedAny: []*anypb.Any{payload("p", "a", "bb"), unknown(1)}},
+			y:  &anyunpackpb.Anys{RepeatedAny: []*anypb.Any{payload("p", "bb", "a"), unknown(1)}},
+			eq: true,
+		}, {
+			x:  &anyunpackpb.Anys{MapInt32Any: map[int32]*anypb.Any{1: payload("p", "a", "bb")}},
+			y:  &anyunpackpb.Anys{MapInt32Any: map[int32]*anypb.Any{1: payload("p", "bb", "a")}},
+			eq: true,
+		}, {
+			x:  &anyunpackpb.Anys{OneofField: &anyunpackpb.Anys_OneofAny{OneofAny: payload("p", "a", "bb")}},
+			y:  &anyunpackpb.Anys{OneofField: &anyunpackpb.Anys_OneofAny{OneofAny: payload("p", "bb", "a")}},
+			eq: true,
+		}, {
+			x: &anyunpackpb.Anys{OneofField: &anyunpackpb.Anys_OneofAny{OneofAny: payload("p", "a")}},
+			y: &anyunpackpb.Anys{OneofField: &anyunpackpb.Anys_OneofAny{OneofAny: payload("p", "bb")}},
+		}, {
+			x: &anyunpackpb.Anys{Any: payload("p", "a", "bb")},
+			y: &anyunpackpb.Anys{Any: withTypeURL(payload("p", "bb", "a"), "example.com/goproto.proto.anyunpack.Payload")},
+		}, {
+			x:  &anyunpackpb.Anys{ByName: payload("p", "a", "bb")},
+			y:  &anyunpackpb.Anys{ByName: withTypeURL(payload("p", "bb", "a"), "example.com/goproto.proto.anyunpack.Payload")},
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualFieldMask checks that code generated with field_mask=normalized
+// compares FieldMask values as sets of paths.
+func TestEqualFieldMask(t *testing.T) {
+	mask := func(paths ...string) *fieldmaskpb.FieldMask {
+		return &fieldmaskpb.FieldMask{Paths: paths}
+	}
+
+	tests := []struct {
+		x, y *maskspb.Masks
+		eq   bool
+	}{
+		{
+			x:  &maskspb.Masks{Mask: mask("a", "b")},
+			y:  &maskspb.Masks{Mask: mask("b", "a", "a.c")},
+			eq: true,
+		}, {
+			x: &maskspb.Masks{Mask: mask("a")},
+			y: &maskspb.Masks{Mask: mask("a.c")},
+		}, {
+			x: &maskspb.Masks{Mask: mask()},
+			y: &maskspb.Masks{},
+		}, {
+			x:  &maskspb.Masks{RepeatedMask: []*fieldmaskpb.FieldMask{mask("a", "a")}},
+			y:  &maskspb.Masks{RepeatedMask: []*fieldmaskpb.FieldMask{mask("a")}},
+			eq: true,
+		}, {
+			x:  &maskspb.Masks{MapStringMask: map[string]*fieldmaskpb.FieldMask{"k": mask("x.y", "x")}},
+			y:  &maskspb.Masks{MapStringMask: map[string]*fieldmaskpb.FieldMask{"k": mask("x")}},
+			eq: true,
+		}, {
+			x:  &maskspb.Masks{OneofField: &maskspb.Masks_OneofMask{OneofMask: mask("b", "a")}},
+			y:  &maskspb.Masks{OneofField: &maskspb.Masks_OneofMask{OneofMask: mask("a", "b")}},
+			eq: true,
+		}, {
+			x: &maskspb.Masks{OneofField: &maskspb.Masks_OneofMask{OneofMask: mask("a")}},
+			y: &maskspb.Masks{OneofField: &maskspb.Masks_OneofString{OneofString: "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+
+	x := &maskspb.Masks{Mask: mask("b", "a")}
+	y := &maskspb.Masks{Mask: mask("b", "a")}
+	if allocs := testing.AllocsPerRun(100, func() { x.Equal(y) }); allocs != 0 {
+		t.Errorf("Equal() of identical masks allocates %v times, want 0", allocs)
+	}
+}
+
+// TestEqualStringMode checks that string fields with (equal.field).string_mode
+// are compared case-insensitively or in Unicode Normalization Form C.
+func TestEqualStringMode(t *testing.T) {
+	const (
+		composed   = "caf\u00e9"  // é as a single code point
+		decomposed = "cafe\u0301" // e followed by a combining acute accent
+	)
+
+	tests := []struct {
+		x, y *optionspb.Contact
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Contact{Email: "Alice@Example.com"},
+			y:  &optionspb.Contact{Email: "alice@example.COM"},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Email: "alice@example.com"},
+			y: &optionspb.Contact{Email: "alice@example.org"},
+		}, {
+			x:  &optionspb.Contact{Name: proto.String(composed)},
+			y:  &optionspb.Contact{Name: proto.String(decomposed)},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Name: proto.String("Cafe")},
+			y: &optionspb.Contact{Name: proto.String("cafe")},
+		}, {
+			x: &optionspb.Contact{Name: proto.String("")},
+			y: &optionspb.Contact{},
+		}, {
+			x:  &optionspb.Contact{Hosts: []string{"Example.com", "\u212a.org"}},
+			y:  &optionspb.Contact{Hosts: []string{"example.COM", "k.org"}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Hosts: []string{"a", "b"}},
+			y: &optionspb.Contact{Hosts: []string{"B", "A"}},
+		}, {
+			x:  &optionspb.Contact{Tags: []string{composed, "x"}},
+			y:  &optionspb.Contact{Tags: []string{"x", decomposed}},
+			eq: true,
+		}, {
+			x:  &optionspb.Contact{Labels: map[string]string{"env": "Prod"}},
+			y:  &optionspb.Contact{Labels: map[string]string{"env": "PROD"}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Labels: map[string]string{"env": "prod"}},
+			y: &optionspb.Contact{Labels: map[string]string{"Env": "prod"}},
+		}, {
+			x:  &optionspb.Contact{Ports: map[string]int32{"HTTP": 80, "https": 443}},
+			y:  &optionspb.Contact{Ports: map[string]int32{"http": 80, "HTTPS": 443}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Ports: map[string]int32{"HTTP": 80}},
+			y: &optionspb.Contact{Ports: map[string]int32{"http": 8080}},
+		}, {
+			x:  &optionspb.Contact{Ports: map[string]int32{"a": 1, "A": 2}},
+			y:  &optionspb.Contact{Ports: map[string]int32{"A": 1, "a": 2}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Ports: map[string]int32{"a": 1, "A": 1}},
+			y: &optionspb.Contact{Ports: map[string]int32{"a": 1, "b": 1}},
+		}, {
+			x:  &optionspb.Contact{Aliases: map[string]string{composed: decomposed}},
+			y:  &optionspb.Contact{Aliases: map[string]string{decomposed: composed}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Exact: "a"},
+			y: &optionspb.Contact{Exact: "A"},
+		}, {
+			x:  &optionspb.Contact{Id: &optionspb.Contact_Handle{Handle: "Bob"}},
+			y:  &optionspb.Contact{Id: &optionspb.Contact_Handle{Handle: "bob"}},
+			eq: true,
+		}, {
+			x: &optionspb.Contact{Id: &optionspb.Contact_Handle{Handle: ""}},
+			y: &optionspb.Contact{},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+func TestEqualContentType(t *testing.T) {
+	marshal := func(m proto.Message) []byte {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		x, y *optionspb.Document
+		eq   bool
+	}{
+		{
+			x:  &optionspb.Document{Body: []byte(`{"a": 1, "b": [true, null]}`)},
+			y:  &optionspb.Document{Body: []byte(`{"b":[true,null],"a":1.0}`)},
+			eq: true,
+		}, {
+			x: &optionspb.Document{Body: []byte(`{"a": 1}`)},
+			y: &optionspb.Document{Body: []byte(`{"a": "1"}`)},
+		}, {
+			x: &optionspb.Document{Body: []byte(`{"a": 1`)},
+			y: &optionspb.Document{Body: []byte(`{"a":1`)},
+		}, {
+			x:  &optionspb.Document{Draft: []byte(` [] `)},
+			y:  &optionspb.Document{Draft: []byte(`[]`)},
+			eq: true,
+		}, {
+			x: &optionspb.Document{Draft: []byte{}},
+			y: &optionspb.Document{},
+		}, {
+			x:  &optionspb.Document{Pages: [][]byte{[]byte(`"x"`), []byte(`1e2`)}},
+			y:  &optionspb.Document{Pages: [][]byte{[]byte(` "x"`), []byte(`100`)}},
+			eq: true,
+		}, {
+			x: &optionspb.Document{Pages: [][]byte{[]byte(`1`), []byte(`2`)}},
+			y: &optionspb.Document{Pages: [][]byte{[]byte(`2`), []byte(`1`)}},
+		}, {
+			x:  &optionspb.Document{Attachments: map[string][]byte{"a": []byte(`{"x": {}, "y": []}`)}},
+			y:  &optionspb.Document{Attachments: map[string][]byte{"a": []byte(`{"y":[],"x":{}}`)}},
+			eq: true,
+		}, {
+			x:  &optionspb.Document{Resource: marshal(&optionspb.Resource{Id: "a", Etag: "1"})},
+			y:  &optionspb.Document{Resource: marshal(&optionspb.Resource{Id: "a", Etag: "2"})},
+			eq: true,
+		}, {
+			x: &optionspb.Document{Resource: marshal(&optionspb.Resource{Id: "a"})},
+			y: &optionspb.Document{Resource: marshal(&optionspb.Resource{Id: "b"})},
+		}, {
+			x: &optionspb.Document{Raw: []byte(`{"a":1}`)},
+			y: &optionspb.Document{Raw: []byte(`{"a": 1}`)},
+		}, {
+			x:  &optionspb.Document{Meta: &optionspb.Document_MetaJson{MetaJson: []byte(`null`)}},
+			y:  &optionspb.Document{Meta: &optionspb.Document_MetaJson{MetaJson: []byte(` null`)}},
+			eq: true,
+		}, {
+			x: &optionspb.Document{Meta: &optionspb.Document_MetaJson{MetaJson: []byte(`null`)}},
+			y: &optionspb.Document{Meta: &optionspb.Document_MetaText{MetaText: "null"}},
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
+	}
+}
+
+// TestEqualFloatProto checks that code generated with float=proto has the same
+// semantics as proto.Equal.
+func TestEqualFloatProto(t *testing.T) {
+	nan := math.NaN()
+	negZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		x, y *floatpb.Floats
+	}{
+		{x: &floatpb.Floats{SingularDouble: nan}, y: &floatpb.Floats{SingularDouble: nan}},
+		{x: &floatpb.Floats{SingularDouble: nan}, y: &floatpb.Floats{SingularDouble: 0}},
+		{x: &floatpb.Floats{SingularDouble: negZero}, y: &floatpb.Floats{SingularDouble: 0}},
+		{x: &floatpb.Floats{SingularDouble: negZero}, y: &floatpb.Floats{SingularDouble: negZero}},
+		{x: &floatpb.Floats{SingularFloat: float32(negZero)}, y: &floatpb.Floats{SingularFloat: 0}},
+		{x: &floatpb.Floats{OptionalDouble: proto.Float64(nan)}, y: &floatpb.Floats{OptionalDouble: proto.Float64(nan)}},
+		{x: &floatpb.Floats{OptionalDouble: proto.Float64(negZero)}, y: &floatpb.Floats{OptionalDouble: proto.Float64(0)}},
+		{x: &floatpb.Floats{RepeatedDouble: []float64{nan}}, y: &floatpb.Floats{RepeatedDouble: []float64{nan}}},
+		{x: &floatpb.Floats{RepeatedDouble: []float64{negZero}}, y: &floatpb.Floats{RepeatedDouble: []float64{0}}},
+		{x: &floatpb.Floats{MapInt32Double: map[int32]float64{1: nan}}, y: &floatpb.Floats{MapInt32Double: map[int32]float64{1: nan}}},
+		{x: &floatpb.Floats{MapInt32Float: map[int32]float32{1: float32(negZero)}}, y: &floatpb.Floats{MapInt32Float: map[int32]float32{1: 0}}},
+		{x: &floatpb.Floats{OneofField: &floatpb.Floats_OneofDouble{OneofDouble: nan}}, y: &floatpb.Floats{OneofField: &floatpb.Floats_OneofDouble{OneofDouble: nan}}},
+		{x: &floatpb.Floats{OneofField: &floatpb.Floats_OneofDouble{OneofDouble: negZero}}, y: &floatpb.Floats{OneofField: &floatpb.Floats_OneofDouble{OneofDouble: 0}}},
+		{x: &floatpb.Floats{OneofField: &floatpb.Floats_OneofDouble{}}, y: &floatpb.Floats{}},
+		{x: &floatpb.Floats{WrappersDoubleValue: wrapperspb.Double(nan)}, y: &floatpb.Floats{WrappersDoubleValue: wrapperspb.Double(nan)}},
+		{x: &floatpb.Floats{WrappersDoubleValue: wrapperspb.Double(negZero)}, y: &floatpb.Floats{WrappersDoubleValue: wrapperspb.Double(0)}},
+		{x: &floatpb.Floats{WrappersFloatValue: wrapperspb.Float(float32(negZero))}, y: &floatpb.Floats{WrappersFloatValue: wrapperspb.Float(0)}},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, proto.Equal(tt.x, tt.y))
+	}
+}
+
+// TestEqualFloatBits checks that code generated with float=bits compares the
+// bit representation of float values.
+func TestEqualFloatBits(t *testing.T) {
+	nan := math.NaN()
+	otherNaN := math.Float64frombits(math.Float64bits(nan) ^ 1)
+	negZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		x, y *floatbitspb.Floats
+		eq   bool
+	}{
+		{
+			x:  &floatbitspb.Floats{SingularDouble: nan},
+			y:  &floatbitspb.Floats{SingularDouble: nan},
+			eq: true,
+		}, {
+			x: &floatbitspb.Floats{SingularDouble: nan},
+			y: &floatbitspb.Floats{SingularDouble: otherNaN},
+		}, {
+			x: &floatbitspb.Floats{SingularDouble: negZero},
+			y: &floatbitspb.Floats{SingularDouble: 0},
+		}, {
+			x: &floatbitspb.Floats{SingularFloat: float32(negZero)},
+			y: &floatbitspb.Floats{SingularFloat: 0},
+		}, {
+			x: &floatbitspb.Floats{OptionalDouble: proto.Float64(negZero)},
+			y: &floatbitspb.Floats{OptionalDouble: proto.Float64(0)},
+		}, {
+			x:  &floatbitspb.Floats{OptionalFloat: proto.Float32(1.5)},
+			y:  &floatbitspb.Floats{OptionalFloat: proto.Float32(1.5)},
+			eq: true,
+		}, {
+			x: &floatbitspb.Floats{RepeatedDouble: []float64{1, nan}},
+			y: &floatbitspb.Floats{RepeatedDouble: []float64{1, otherNaN}},
+		}, {
+			x:  &floatbitspb.Floats{RepeatedFloat: []float32{1, float32(nan)}},
+			y:  &floatbitspb.Floats{RepeatedFloat: []float32{1, float32(nan)}},
+			eq: true,
+		}, {
+			x: &floatbitspb.Floats{MapInt32Double: map[int32]float64{1: negZero}},
+			y: &floatbitspb.Floats{MapInt32Double: map[int32]float64{1: 0}},
+		}, {
+			x: &floatbitspb.Floats{OneofField: &floatbitspb.Floats_OneofDouble{OneofDouble: negZero}},
+			y: &floatbitspb.Floats{OneofField: &floatbitspb.Floats_OneofDouble{OneofDouble: 0}},
+		}, {
+			x: &floatbitspb.Floats{WrappersDoubleValue: wrapperspb.Double(nan)},
+			y: &floatbitspb.Floats{WrappersDoubleValue: wrapperspb.Double(otherNaN)},
+		}, {
+			x:  &floatbitspb.Floats{WrappersFloatValue: wrapperspb.Float(2)},
+			y:  &floatbitspb.Floats{WrappersFloatValue: wrapperspb.Float(2)},
+			eq: true,
+		},
+	}
+
+	for _, tt := range tests {
+		checkEqual(t, tt.x, tt.y, tt.eq)
 	}
 }
 
@@ -1,4 +0,0 @@
-// Code generated by protoc-gen-equal-go. DO NOT EDIT.
-// source: internal/testprotos/enums3/enums3.proto
-
-package enums3
@@ -3,6 +3,12 @@
 
 package other
 
+import (
+	equal "github.com/melias122/protoc-gen-go-equal/equal"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maphash "hash/maphash"
+)
+
 func (x *OtherMessage) Equal(y *OtherMessage) bool {
 	if x == y {
 		return true
@@ -13,5 +19,76 @@ func (x *OtherMessage) Equal(y *OtherMessage) bool {
 	if x.I != y.I {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
+
+func init() {
+	equal.Register("goproto.proto.other.OtherMessage", (*OtherMessage).Equal)
+}
+
+func (x *OtherMessage) Diff(y *OtherMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if x.I != y.I {
+		d = append(d, equal.Difference{Path: "i", X: x.I, Y: y.I})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *OtherMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(x.I))
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *OtherMessage) Compare(y *OtherMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareOrdered(x.I, y.I); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *OtherMessage) ChangedFields(y *OtherMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &OtherMessage{}
+	}
+	if y == nil {
+		y = &OtherMessage{}
+	}
+	var paths []string
+	if x.I != y.I {
+		paths = append(paths, "i")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -4,7 +4,12 @@
 package test
 
 import (
-	math "math"
+	bytes "bytes"
+	equal "github.com/melias122/protoc-gen-go-equal/equal"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maphash "hash/maphash"
 )
 
 func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
@@ -14,12 +19,15 @@ func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
 	if !x.Corecursive.Equal(y.Corecursive) {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -30,13 +38,16 @@ func (x *TestAllTypes_OptionalGroup) Equal(y *TestAllTypes_OptionalGroup) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
 	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
 		return false
 	}
-	if p, q := x.SameFieldNumber, y.SameFieldNumber; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -49,12 +60,15 @@ func (x *TestAllTypes_RepeatedGroup) Equal(y *TestAllTypes_RepeatedGroup) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
 	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -65,10 +79,13 @@ func (x *TestAllTypes_OneofGroup) Equal(y *TestAllTypes_OneofGroup) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
+		return false
+	}
+	if !equal.OptionalEqual(x.B, y.B) {
 		return false
 	}
-	if p, q := x.B, y.B; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -81,49 +98,49 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.OptionalInt32, y.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
 		return false
 	}
-	if p, q := x.OptionalInt64, y.OptionalInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
 		return false
 	}
-	if p, q := x.OptionalUint32, y.OptionalUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
 		return false
 	}
-	if p, q := x.OptionalUint64, y.OptionalUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
 		return false
 	}
-	if p, q := x.OptionalSint32, y.OptionalSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
 		return false
 	}
-	if p, q := x.OptionalSint64, y.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
 		return false
 	}
-	if p, q := x.OptionalFixed32, y.OptionalFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
 		return false
 	}
-	if p, q := x.OptionalFixed64, y.OptionalFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
 		return false
 	}
-	if p, q := x.OptionalSfixed32, y.OptionalSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
 		return false
 	}
-	if p, q := x.OptionalSfixed64, y.OptionalSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
 		return false
 	}
-	if p, q := x.OptionalFloat, y.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
 		return false
 	}
-	if p, q := x.OptionalDouble, y.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
 		return false
 	}
-	if p, q := x.OptionalBool, y.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
 		return false
 	}
-	if p, q := x.OptionalString, y.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
 		return false
 	}
-	if p, q := x.OptionalBytes, y.OptionalBytes; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q))) {
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
 		return false
 	}
 	if !x.Optionalgroup.Equal(y.Optionalgroup) {
@@ -138,592 +155,445 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if !x.OptionalImportMessage.Equal(y.OptionalImportMessage) {
 		return false
 	}
-	if p, q := x.OptionalNestedEnum, y.OptionalNestedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
 		return false
 	}
-	if p, q := x.OptionalForeignEnum, y.OptionalForeignEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
 		return false
 	}
-	if p, q := x.OptionalImportEnum, y.OptionalImportEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
 		return false
 	}
-	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+	if !equal.SliceEqual(x.RepeatedInt32, y.RepeatedInt32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedInt32); i++ {
-		if x.RepeatedInt32[i] != y.RepeatedInt32[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedInt64, y.RepeatedInt64) {
+		return false
 	}
-	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+	if !equal.SliceEqual(x.RepeatedUint32, y.RepeatedUint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedInt64); i++ {
-		if x.RepeatedInt64[i] != y.RepeatedInt64[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedUint64, y.RepeatedUint64) {
+		return false
 	}
-	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+	if !equal.SliceEqual(x.RepeatedSint32, y.RepeatedSint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedUint32); i++ {
-		if x.RepeatedUint32[i] != y.RepeatedUint32[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedSint64, y.RepeatedSint64) {
+		return false
 	}
-	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+	if !equal.SliceEqual(x.RepeatedFixed32, y.RepeatedFixed32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedUint64); i++ {
-		if x.RepeatedUint64[i] != y.RepeatedUint64[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedFixed64, y.RepeatedFixed64) {
+		return false
 	}
-	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+	if !equal.SliceEqual(x.RepeatedSfixed32, y.RepeatedSfixed32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSint32); i++ {
-		if x.RepeatedSint32[i] != y.RepeatedSint32[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedSfixed64, y.RepeatedSfixed64) {
+		return false
 	}
-	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+	if !equal.SliceEqualFunc(x.RepeatedFloat, y.RepeatedFloat, equal.FloatEqual[float32]) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSint64); i++ {
-		if x.RepeatedSint64[i] != y.RepeatedSint64[i] {
-			return false
-		}
+	if !equal.SliceEqualFunc(x.RepeatedDouble, y.RepeatedDouble, equal.FloatEqual[float64]) {
+		return false
 	}
-	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+	if !equal.SliceEqual(x.RepeatedBool, y.RepeatedBool) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFixed32); i++ {
-		if x.RepeatedFixed32[i] != y.RepeatedFixed32[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedString, y.RepeatedString) {
+		return false
 	}
-	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+	if !equal.SliceEqualFunc(x.RepeatedBytes, y.RepeatedBytes, equal.BytesEqual) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFixed64); i++ {
-		if x.RepeatedFixed64[i] != y.RepeatedFixed64[i] {
-			return false
-		}
+	if !equal.SliceEqualFunc(x.Repeatedgroup, y.Repeatedgroup, (*TestAllTypes_RepeatedGroup).Equal) {
+		return false
 	}
-	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+	if !equal.SliceEqualFunc(x.RepeatedNestedMessage, y.RepeatedNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSfixed32); i++ {
-		if x.RepeatedSfixed32[i] != y.RepeatedSfixed32[i] {
-			return false
-		}
+	if !equal.SliceEqualFunc(x.RepeatedForeignMessage, y.RepeatedForeignMessage, (*ForeignMessage).Equal) {
+		return false
 	}
-	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+	if !equal.SliceEqualFunc(x.RepeatedImportmessage, y.RepeatedImportmessage, (*ImportMessage).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSfixed64); i++ {
-		if x.RepeatedSfixed64[i] != y.RepeatedSfixed64[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedNestedEnum, y.RepeatedNestedEnum) {
+		return false
 	}
-	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+	if !equal.SliceEqual(x.RepeatedForeignEnum, y.RepeatedForeignEnum) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFloat); i++ {
-		if (math.IsNaN(float64(x.RepeatedFloat[i])) && !math.IsNaN(float64(y.RepeatedFloat[i])) || !math.IsNaN(float64(x.RepeatedFloat[i])) && math.IsNaN(float64(y.RepeatedFloat[i]))) || (!math.IsNaN(float64(x.RepeatedFloat[i])) && !math.IsNaN(float64(y.RepeatedFloat[i])) && x.RepeatedFloat[i] != y.RepeatedFloat[i]) {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedImportenum, y.RepeatedImportenum) {
+		return false
 	}
-	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+	if !equal.MapEqual(x.MapInt32Int32, y.MapInt32Int32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedDouble); i++ {
-		if (math.IsNaN(float64(x.RepeatedDouble[i])) && !math.IsNaN(float64(y.RepeatedDouble[i])) || !math.IsNaN(float64(x.RepeatedDouble[i])) && math.IsNaN(float64(y.RepeatedDouble[i]))) || (!math.IsNaN(float64(x.RepeatedDouble[i])) && !math.IsNaN(float64(y.RepeatedDouble[i])) && x.RepeatedDouble[i] != y.RepeatedDouble[i]) {
-			return false
-		}
+	if !equal.MapEqual(x.MapInt64Int64, y.MapInt64Int64) {
+		return false
 	}
-	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+	if !equal.MapEqual(x.MapUint32Uint32, y.MapUint32Uint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedBool); i++ {
-		if x.RepeatedBool[i] != y.RepeatedBool[i] {
-			return false
-		}
+	if !equal.MapEqual(x.MapUint64Uint64, y.MapUint64Uint64) {
+		return false
 	}
-	if len(x.RepeatedString) != len(y.RepeatedString) {
+	if !equal.MapEqual(x.MapSint32Sint32, y.MapSint32Sint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedString); i++ {
-		if x.RepeatedString[i] != y.RepeatedString[i] {
-			return false
-		}
+	if !equal.MapEqual(x.MapSint64Sint64, y.MapSint64Sint64) {
+		return false
 	}
-	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+	if !equal.MapEqual(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedBytes); i++ {
-		if string(x.RepeatedBytes[i]) != string(y.RepeatedBytes[i]) {
-			return false
-		}
+	if !equal.MapEqual(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		return false
 	}
-	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+	if !equal.MapEqual(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
 		return false
 	}
-	for i := 0; i < len(x.Repeatedgroup); i++ {
-		if !x.Repeatedgroup[i].Equal(y.Repeatedgroup[i]) {
-			return false
-		}
+	if !equal.MapEqual(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		return false
 	}
-	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+	if !equal.MapEqualFunc(x.MapInt32Float, y.MapInt32Float, equal.FloatEqual[float32]) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedNestedMessage); i++ {
-		if !x.RepeatedNestedMessage[i].Equal(y.RepeatedNestedMessage[i]) {
-			return false
-		}
+	if !equal.MapEqualFunc(x.MapInt32Double, y.MapInt32Double, equal.FloatEqual[float64]) {
+		return false
 	}
-	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+	if !equal.MapEqual(x.MapBoolBool, y.MapBoolBool) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedForeignMessage); i++ {
-		if !x.RepeatedForeignMessage[i].Equal(y.RepeatedForeignMessage[i]) {
-			return false
-		}
+	if !equal.MapEqual(x.MapStringString, y.MapStringString) {
+		return false
 	}
-	if len(x.RepeatedImportmessage) != len(y.RepeatedImportmessage) {
+	if !equal.MapEqualFunc(x.MapStringBytes, y.MapStringBytes, equal.BytesEqual) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedImportmessage); i++ {
-		if !x.RepeatedImportmessage[i].Equal(y.RepeatedImportmessage[i]) {
-			return false
-		}
+	if !equal.MapEqualFunc(x.MapStringNestedMessage, y.MapStringNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		return false
 	}
-	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+	if !equal.MapEqual(x.MapStringNestedEnum, y.MapStringNestedEnum) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedNestedEnum); i++ {
-		if x.RepeatedNestedEnum[i] != y.RepeatedNestedEnum[i] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultInt32, y.DefaultInt32) {
+		return false
 	}
-	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+	if !equal.OptionalEqual(x.DefaultInt64, y.DefaultInt64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedForeignEnum); i++ {
-		if x.RepeatedForeignEnum[i] != y.RepeatedForeignEnum[i] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultUint32, y.DefaultUint32) {
+		return false
 	}
-	if len(x.RepeatedImportenum) != len(y.RepeatedImportenum) {
+	if !equal.OptionalEqual(x.DefaultUint64, y.DefaultUint64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedImportenum); i++ {
-		if x.RepeatedImportenum[i] != y.RepeatedImportenum[i] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultSint32, y.DefaultSint32) {
+		return false
 	}
-	if len(x.MapInt32Int32) != len(y.MapInt32Int32) {
+	if !equal.OptionalEqual(x.DefaultSint64, y.DefaultSint64) {
 		return false
 	}
-	for k := range x.MapInt32Int32 {
-		_, ok := y.MapInt32Int32[k]
-		if !ok {
-			return false
-		}
-		if x.MapInt32Int32[k] != y.MapInt32Int32[k] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultFixed32, y.DefaultFixed32) {
+		return false
 	}
-	if len(x.MapInt64Int64) != len(y.MapInt64Int64) {
+	if !equal.OptionalEqual(x.DefaultFixed64, y.DefaultFixed64) {
 		return false
 	}
-	for k := range x.MapInt64Int64 {
-		_, ok := y.MapInt64Int64[k]
-		if !ok {
-			return false
-		}
-		if x.MapInt64Int64[k] != y.MapInt64Int64[k] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultSfixed32, y.DefaultSfixed32) {
+		return false
 	}
-	if len(x.MapUint32Uint32) != len(y.MapUint32Uint32) {
+	if !equal.OptionalEqual(x.DefaultSfixed64, y.DefaultSfixed64) {
 		return false
 	}
-	for k := range x.MapUint32Uint32 {
-		_, ok := y.MapUint32Uint32[k]
-		if !ok {
-			return false
-		}
-		if x.MapUint32Uint32[k] != y.MapUint32Uint32[k] {
-			return false
-		}
+	if !equal.OptionalFloatEqual(x.DefaultFloat, y.DefaultFloat) {
+		return false
 	}
-	if len(x.MapUint64Uint64) != len(y.MapUint64Uint64) {
+	if !equal.OptionalFloatEqual(x.DefaultDouble, y.DefaultDouble) {
 		return false
 	}
-	for k := range x.MapUint64Uint64 {
-		_, ok := y.MapUint64Uint64[k]
-		if !ok {
-			return false
-		}
-		if x.MapUint64Uint64[k] != y.MapUint64Uint64[k] {
-			return false
-		}
+	if !equal.OptionalEqual(x.DefaultBool, y.DefaultBool) {
+		return false
 	}
-	if len(x.MapSint32Sint32) != len(y.MapSint32Sint32) {
+	if !equal.OptionalEqual(x.DefaultString, y.DefaultString) {
 		return false
 	}
-	for k := range x.MapSint32Sint32 {
-		_, ok := y.MapSint32Sint32[k]
-		if !ok {
-			return false
-		}
-		if x.MapSint32Sint32[k] != y.MapSint32Sint32[k] {
-			return false
-		}
+	if !equal.OptionalBytesEqual(x.DefaultBytes, y.DefaultBytes) {
+		return false
 	}
-	if len(x.MapSint64Sint64) != len(y.MapSint64Sint64) {
+	if !equal.OptionalEqual(x.DefaultNestedEnum, y.DefaultNestedEnum) {
 		return false
 	}
-	for k := range x.MapSint64Sint64 {
-		_, ok := y.MapSint64Sint64[k]
+	if !equal.OptionalEqual(x.DefaultForeignEnum, y.DefaultForeignEnum) {
+		return false
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return false
+		}
+	case *TestAllTypes_OneofUint32:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofUint32)
 		if !ok {
 			return false
 		}
-		if x.MapSint64Sint64[k] != y.MapSint64Sint64[k] {
+		if xv.OneofUint32 != yv.OneofUint32 {
 			return false
 		}
-	}
-	if len(x.MapFixed32Fixed32) != len(y.MapFixed32Fixed32) {
-		return false
-	}
-	for k := range x.MapFixed32Fixed32 {
-		_, ok := y.MapFixed32Fixed32[k]
+	case *TestAllTypes_OneofNestedMessage:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage)
 		if !ok {
 			return false
 		}
-		if x.MapFixed32Fixed32[k] != y.MapFixed32Fixed32[k] {
+		if !xv.OneofNestedMessage.Equal(yv.OneofNestedMessage) {
 			return false
 		}
-	}
-	if len(x.MapFixed64Fixed64) != len(y.MapFixed64Fixed64) {
-		return false
-	}
-	for k := range x.MapFixed64Fixed64 {
-		_, ok := y.MapFixed64Fixed64[k]
+	case *TestAllTypes_OneofString:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofString)
 		if !ok {
 			return false
 		}
-		if x.MapFixed64Fixed64[k] != y.MapFixed64Fixed64[k] {
+		if xv.OneofString != yv.OneofString {
 			return false
 		}
-	}
-	if len(x.MapSfixed32Sfixed32) != len(y.MapSfixed32Sfixed32) {
-		return false
-	}
-	for k := range x.MapSfixed32Sfixed32 {
-		_, ok := y.MapSfixed32Sfixed32[k]
+	case *TestAllTypes_OneofBytes:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofBytes)
 		if !ok {
 			return false
 		}
-		if x.MapSfixed32Sfixed32[k] != y.MapSfixed32Sfixed32[k] {
+		if string(xv.OneofBytes) != string(yv.OneofBytes) {
 			return false
 		}
-	}
-	if len(x.MapSfixed64Sfixed64) != len(y.MapSfixed64Sfixed64) {
-		return false
-	}
-	for k := range x.MapSfixed64Sfixed64 {
-		_, ok := y.MapSfixed64Sfixed64[k]
+	case *TestAllTypes_OneofBool:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofBool)
 		if !ok {
 			return false
 		}
-		if x.MapSfixed64Sfixed64[k] != y.MapSfixed64Sfixed64[k] {
+		if xv.OneofBool != yv.OneofBool {
 			return false
 		}
-	}
-	if len(x.MapInt32Float) != len(y.MapInt32Float) {
-		return false
-	}
-	for k := range x.MapInt32Float {
-		_, ok := y.MapInt32Float[k]
+	case *TestAllTypes_OneofUint64:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofUint64)
 		if !ok {
 			return false
 		}
-		if (math.IsNaN(float64(x.MapInt32Float[k])) && !math.IsNaN(float64(y.MapInt32Float[k])) || !math.IsNaN(float64(x.MapInt32Float[k])) && math.IsNaN(float64(y.MapInt32Float[k]))) || (!math.IsNaN(float64(x.MapInt32Float[k])) && !math.IsNaN(float64(y.MapInt32Float[k])) && x.MapInt32Float[k] != y.MapInt32Float[k]) {
+		if xv.OneofUint64 != yv.OneofUint64 {
 			return false
 		}
-	}
-	if len(x.MapInt32Double) != len(y.MapInt32Double) {
-		return false
-	}
-	for k := range x.MapInt32Double {
-		_, ok := y.MapInt32Double[k]
+	case *TestAllTypes_OneofFloat:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofFloat)
 		if !ok {
 			return false
 		}
-		if (math.IsNaN(float64(x.MapInt32Double[k])) && !math.IsNaN(float64(y.MapInt32Double[k])) || !math.IsNaN(float64(x.MapInt32Double[k])) && math.IsNaN(float64(y.MapInt32Double[k]))) || (!math.IsNaN(float64(x.MapInt32Double[k])) && !math.IsNaN(float64(y.MapInt32Double[k])) && x.MapInt32Double[k] != y.MapInt32Double[k]) {
+		if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
 			return false
 		}
-	}
-	if len(x.MapBoolBool) != len(y.MapBoolBool) {
-		return false
-	}
-	for k := range x.MapBoolBool {
-		_, ok := y.MapBoolBool[k]
+	case *TestAllTypes_OneofDouble:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofDouble)
 		if !ok {
 			return false
 		}
-		if x.MapBoolBool[k] != y.MapBoolBool[k] {
+		if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
 			return false
 		}
-	}
-	if len(x.MapStringString) != len(y.MapStringString) {
-		return false
-	}
-	for k := range x.MapStringString {
-		_, ok := y.MapStringString[k]
+	case *TestAllTypes_OneofEnum:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofEnum)
 		if !ok {
 			return false
 		}
-		if x.MapStringString[k] != y.MapStringString[k] {
+		if xv.OneofEnum != yv.OneofEnum {
 			return false
 		}
-	}
-	if len(x.MapStringBytes) != len(y.MapStringBytes) {
-		return false
-	}
-	for k := range x.MapStringBytes {
-		_, ok := y.MapStringBytes[k]
+	case *TestAllTypes_Oneofgroup:
+		yv, ok := y.OneofField.(*TestAllTypes_Oneofgroup)
 		if !ok {
 			return false
 		}
-		if string(x.MapStringBytes[k]) != string(y.MapStringBytes[k]) {
+		if !xv.Oneofgroup.Equal(yv.Oneofgroup) {
 			return false
 		}
-	}
-	if len(x.MapStringNestedMessage) != len(y.MapStringNestedMessage) {
-		return false
-	}
-	for k := range x.MapStringNestedMessage {
-		_, ok := y.MapStringNestedMessage[k]
+	case *TestAllTypes_OneofWrappersStringValue:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue)
 		if !ok {
 			return false
 		}
-		if !x.MapStringNestedMessage[k].Equal(y.MapStringNestedMessage[k]) {
+		if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 			return false
 		}
 	}
-	if len(x.MapStringNestedEnum) != len(y.MapStringNestedEnum) {
-		return false
-	}
-	for k := range x.MapStringNestedEnum {
-		_, ok := y.MapStringNestedEnum[k]
+	switch xv := x.OneofOptional.(type) {
+	case nil:
+		if y.OneofOptional != nil {
+			return false
+		}
+	case *TestAllTypes_OneofOptionalUint32:
+		yv, ok := y.OneofOptional.(*TestAllTypes_OneofOptionalUint32)
 		if !ok {
 			return false
 		}
-		if x.MapStringNestedEnum[k] != y.MapStringNestedEnum[k] {
+		if xv.OneofOptionalUint32 != yv.OneofOptionalUint32 {
 			return false
 		}
 	}
-	if p, q := x.DefaultInt32, y.DefaultInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
 		return false
 	}
-	if p, q := x.DefaultInt64, y.DefaultInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
-	if p, q := x.DefaultUint32, y.DefaultUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
 		return false
 	}
-	if p, q := x.DefaultUint64, y.DefaultUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
-	if p, q := x.DefaultSint32, y.DefaultSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultSint64, y.DefaultSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
 		return false
 	}
-	if p, q := x.DefaultFixed32, y.DefaultFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
 		return false
 	}
-	if p, q := x.DefaultFixed64, y.DefaultFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
 		return false
 	}
-	if p, q := x.DefaultSfixed32, y.DefaultSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultSfixed64, y.DefaultSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultFloat, y.DefaultFloat; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultDouble, y.DefaultDouble; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultBool, y.DefaultBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 		return false
 	}
-	if p, q := x.DefaultString, y.DefaultString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
-	if p, q := x.DefaultBytes, y.DefaultBytes; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q))) {
-		return false
+	return true
+}
+
+func (x *TestDeprecatedMessage) Equal(y *TestDeprecatedMessage) bool {
+	if x == y {
+		return true
 	}
-	if p, q := x.DefaultNestedEnum, y.DefaultNestedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if x == nil || y == nil {
+		return x == nil && y == nil
 	}
-	if p, q := x.DefaultForeignEnum, y.DefaultForeignEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.DeprecatedInt32, y.DeprecatedInt32) {
 		return false
 	}
-	if x.GetOneofUint32() != y.GetOneofUint32() {
-		return false
+	switch xv := x.DeprecatedOneof.(type) {
+	case nil:
+		if y.DeprecatedOneof != nil {
+			return false
+		}
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		yv, ok := y.DeprecatedOneof.(*TestDeprecatedMessage_DeprecatedOneofField)
+		if !ok {
+			return false
+		}
+		if xv.DeprecatedOneofField != yv.DeprecatedOneofField {
+			return false
+		}
 	}
-	if !x.GetOneofNestedMessage().Equal(y.GetOneofNestedMessage()) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
-	if x.GetOneofString() != y.GetOneofString() {
-		return false
+	return true
+}
+
+func (x *ForeignMessage) Equal(y *ForeignMessage) bool {
+	if x == y {
+		return true
 	}
-	if string(x.GetOneofBytes()) != string(y.GetOneofBytes()) {
-		return false
+	if x == nil || y == nil {
+		return x == nil && y == nil
 	}
-	if x.GetOneofBool() != y.GetOneofBool() {
+	if !equal.OptionalEqual(x.C, y.C) {
 		return false
 	}
-	if x.GetOneofUint64() != y.GetOneofUint64() {
+	if !equal.OptionalEqual(x.D, y.D) {
 		return false
 	}
-	if (math.IsNaN(float64(x.GetOneofFloat())) && !math.IsNaN(float64(y.GetOneofFloat())) || !math.IsNaN(float64(x.GetOneofFloat())) && math.IsNaN(float64(y.GetOneofFloat()))) || (!math.IsNaN(float64(x.GetOneofFloat())) && !math.IsNaN(float64(y.GetOneofFloat())) && x.GetOneofFloat() != y.GetOneofFloat()) {
-		return false
-	}
-	if (math.IsNaN(float64(x.GetOneofDouble())) && !math.IsNaN(float64(y.GetOneofDouble())) || !math.IsNaN(float64(x.GetOneofDouble())) && math.IsNaN(float64(y.GetOneofDouble()))) || (!math.IsNaN(float64(x.GetOneofDouble())) && !math.IsNaN(float64(y.GetOneofDouble())) && x.GetOneofDouble() != y.GetOneofDouble()) {
-		return false
-	}
-	if x.GetOneofEnum() != y.GetOneofEnum() {
-		return false
-	}
-	if !x.GetOneofgroup().Equal(y.GetOneofgroup()) {
-		return false
-	}
-	if p, q := x.GetOneofWrappersStringValue(), y.GetOneofWrappersStringValue(); (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if x.GetOneofOptionalUint32() != y.GetOneofOptionalUint32() {
-		return false
-	}
-	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
-		return false
-	}
-	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
-		return false
-	}
-	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
-		return false
-	}
-	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
-		return false
-	}
-	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
-		return false
-	}
-	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
-		return false
-	}
-	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
 }
 
-func (x *TestDeprecatedMessage) Equal(y *TestDeprecatedMessage) bool {
+func (x *TestReservedFields) Equal(y *TestReservedFields) bool {
 	if x == y {
 		return true
 	}
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.DeprecatedInt32, y.DeprecatedInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
-	}
-	if x.GetDeprecatedOneofField() != y.GetDeprecatedOneofField() {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
 }
 
-func (x *ForeignMessage) Equal(y *ForeignMessage) bool {
+func (x *TestAllExtensions_NestedMessage) Equal(y *TestAllExtensions_NestedMessage) bool {
 	if x == y {
 		return true
 	}
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.C, y.C; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
-	if p, q := x.D, y.D; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !x.Corecursive.Equal(y.Corecursive) {
 		return false
 	}
-	return true
-}
-
-func (x *TestReservedFields) Equal(y *TestReservedFields) bool {
-	if x == y {
-		return true
-	}
-	if x == nil || y == nil {
-		return x == nil && y == nil
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
 	}
 	return true
 }
 
-func (x *TestAllExtensions_NestedMessage) Equal(y *TestAllExtensions_NestedMessage) bool {
+func (x *TestAllExtensions) Equal(y *TestAllExtensions) bool {
 	if x == y {
 		return true
 	}
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.Extensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, func(p, q protoreflect.Message) bool {
+		switch v := p.Interface().(type) {
+		case *OptionalGroup:
+			return v.Equal(q.Interface().(*OptionalGroup))
+		case *TestAllExtensions_NestedMessage:
+			return v.Equal(q.Interface().(*TestAllExtensions_NestedMessage))
+		case *RepeatedGroup:
+			return v.Equal(q.Interface().(*RepeatedGroup))
+		case *TestRequired:
+			return v.Equal(q.Interface().(*TestRequired))
+		}
+		return proto.Equal(p.Interface(), q.Interface())
+	}) {
 		return false
 	}
-	if !x.Corecursive.Equal(y.Corecursive) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
 }
 
-func (x *TestAllExtensions) Equal(y *TestAllExtensions) bool {
-	if x == y {
-		return true
-	}
-	if x == nil || y == nil {
-		return x == nil && y == nil
-	}
-	return true
-}
-
 func (x *OptionalGroup) Equal(y *OptionalGroup) bool {
 	if x == y {
 		return true
@@ -731,15 +601,18 @@ func (x *OptionalGroup) Equal(y *OptionalGroup) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
-	if p, q := x.SameFieldNumber, y.SameFieldNumber; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
 		return false
 	}
 	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -750,12 +623,15 @@ func (x *RepeatedGroup) Equal(y *RepeatedGroup) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
 	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -766,6 +642,9 @@ func (x *TestNestedExtension) Equal(y *TestNestedExtension) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -776,7 +655,10 @@ func (x *TestRequired) Equal(y *TestRequired) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.RequiredField, y.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.RequiredField, y.RequiredField) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -792,27 +674,27 @@ func (x *TestRequiredForeign) Equal(y *TestRequiredForeign) bool {
 	if !x.OptionalMessage.Equal(y.OptionalMessage) {
 		return false
 	}
-	if len(x.RepeatedMessage) != len(y.RepeatedMessage) {
+	if !equal.SliceEqualFunc(x.RepeatedMessage, y.RepeatedMessage, (*TestRequired).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedMessage); i++ {
-		if !x.RepeatedMessage[i].Equal(y.RepeatedMessage[i]) {
-			return false
-		}
-	}
-	if len(x.MapMessage) != len(y.MapMessage) {
+	if !equal.MapEqualFunc(x.MapMessage, y.MapMessage, (*TestRequired).Equal) {
 		return false
 	}
-	for k := range x.MapMessage {
-		_, ok := y.MapMessage[k]
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return false
+		}
+	case *TestRequiredForeign_OneofMessage:
+		yv, ok := y.OneofField.(*TestRequiredForeign_OneofMessage)
 		if !ok {
 			return false
 		}
-		if !x.MapMessage[k].Equal(y.MapMessage[k]) {
+		if !xv.OneofMessage.Equal(yv.OneofMessage) {
 			return false
 		}
 	}
-	if !x.GetOneofMessage().Equal(y.GetOneofMessage()) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -825,7 +707,10 @@ func (x *TestRequiredGroupFields_OptionalGroup) Equal(y *TestRequiredGroupFields
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -838,7 +723,10 @@ func (x *TestRequiredGroupFields_RepeatedGroup) Equal(y *TestRequiredGroupFields
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -854,13 +742,11 @@ func (x *TestRequiredGroupFields) Equal(y *TestRequiredGroupFields) bool {
 	if !x.Optionalgroup.Equal(y.Optionalgroup) {
 		return false
 	}
-	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+	if !equal.SliceEqualFunc(x.Repeatedgroup, y.Repeatedgroup, (*TestRequiredGroupFields_RepeatedGroup).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.Repeatedgroup); i++ {
-		if !x.Repeatedgroup[i].Equal(y.Repeatedgroup[i]) {
-			return false
-		}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
 	}
 	return true
 }
@@ -872,6 +758,9 @@ func (x *TestWeak) Equal(y *TestWeak) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -882,117 +771,50 @@ func (x *TestPackedTypes) Equal(y *TestPackedTypes) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if len(x.PackedInt32) != len(y.PackedInt32) {
+	if !equal.SliceEqual(x.PackedInt32, y.PackedInt32) {
 		return false
 	}
-	for i := 0; i < len(x.PackedInt32); i++ {
-		if x.PackedInt32[i] != y.PackedInt32[i] {
-			return false
-		}
-	}
-	if len(x.PackedInt64) != len(y.PackedInt64) {
+	if !equal.SliceEqual(x.PackedInt64, y.PackedInt64) {
 		return false
 	}
-	for i := 0; i < len(x.PackedInt64); i++ {
-		if x.PackedInt64[i] != y.PackedInt64[i] {
-			return false
-		}
-	}
-	if len(x.PackedUint32) != len(y.PackedUint32) {
+	if !equal.SliceEqual(x.PackedUint32, y.PackedUint32) {
 		return false
 	}
-	for i := 0; i < len(x.PackedUint32); i++ {
-		if x.PackedUint32[i] != y.PackedUint32[i] {
-			return false
-		}
-	}
-	if len(x.PackedUint64) != len(y.PackedUint64) {
+	if !equal.SliceEqual(x.PackedUint64, y.PackedUint64) {
 		return false
 	}
-	for i := 0; i < len(x.PackedUint64); i++ {
-		if x.PackedUint64[i] != y.PackedUint64[i] {
-			return false
-		}
-	}
-	if len(x.PackedSint32) != len(y.PackedSint32) {
+	if !equal.SliceEqual(x.PackedSint32, y.PackedSint32) {
 		return false
 	}
-	for i := 0; i < len(x.PackedSint32); i++ {
-		if x.PackedSint32[i] != y.PackedSint32[i] {
-			return false
-		}
-	}
-	if len(x.PackedSint64) != len(y.PackedSint64) {
+	if !equal.SliceEqual(x.PackedSint64, y.PackedSint64) {
 		return false
 	}
-	for i := 0; i < len(x.PackedSint64); i++ {
-		if x.PackedSint64[i] != y.PackedSint64[i] {
-			return false
-		}
-	}
-	if len(x.PackedFixed32) != len(y.PackedFixed32) {
+	if !equal.SliceEqual(x.PackedFixed32, y.PackedFixed32) {
 		return false
 	}
-	for i := 0; i < len(x.PackedFixed32); i++ {
-		if x.PackedFixed32[i] != y.PackedFixed32[i] {
-			return false
-		}
-	}
-	if len(x.PackedFixed64) != len(y.PackedFixed64) {
+	if !equal.SliceEqual(x.PackedFixed64, y.PackedFixed64) {
 		return false
 	}
-	for i := 0; i < len(x.PackedFixed64); i++ {
-		if x.PackedFixed64[i] != y.PackedFixed64[i] {
-			return false
-		}
-	}
-	if len(x.PackedSfixed32) != len(y.PackedSfixed32) {
+	if !equal.SliceEqual(x.PackedSfixed32, y.PackedSfixed32) {
 		return false
 	}
-	for i := 0; i < len(x.PackedSfixed32); i++ {
-		if x.PackedSfixed32[i] != y.PackedSfixed32[i] {
-			return false
-		}
-	}
-	if len(x.PackedSfixed64) != len(y.PackedSfixed64) {
+	if !equal.SliceEqual(x.PackedSfixed64, y.PackedSfixed64) {
 		return false
 	}
-	for i := 0; i < len(x.PackedSfixed64); i++ {
-		if x.PackedSfixed64[i] != y.PackedSfixed64[i] {
-			return false
-		}
-	}
-	if len(x.PackedFloat) != len(y.PackedFloat) {
+	if !equal.SliceEqualFunc(x.PackedFloat, y.PackedFloat, equal.FloatEqual[float32]) {
 		return false
 	}
-	for i := 0; i < len(x.PackedFloat); i++ {
-		if (math.IsNaN(float64(x.PackedFloat[i])) && !math.IsNaN(float64(y.PackedFloat[i])) || !math.IsNaN(float64(x.PackedFloat[i])) && math.IsNaN(float64(y.PackedFloat[i]))) || (!math.IsNaN(float64(x.PackedFloat[i])) && !math.IsNaN(float64(y.PackedFloat[i])) && x.PackedFloat[i] != y.PackedFloat[i]) {
-			return false
-		}
-	}
-	if len(x.PackedDouble) != len(y.PackedDouble) {
+	if !equal.SliceEqualFunc(x.PackedDouble, y.PackedDouble, equal.FloatEqual[float64]) {
 		return false
 	}
-	for i := 0; i < len(x.PackedDouble); i++ {
-		if (math.IsNaN(float64(x.PackedDouble[i])) && !math.IsNaN(float64(y.PackedDouble[i])) || !math.IsNaN(float64(x.PackedDouble[i])) && math.IsNaN(float64(y.PackedDouble[i]))) || (!math.IsNaN(float64(x.PackedDouble[i])) && !math.IsNaN(float64(y.PackedDouble[i])) && x.PackedDouble[i] != y.PackedDouble[i]) {
-			return false
-		}
-	}
-	if len(x.PackedBool) != len(y.PackedBool) {
+	if !equal.SliceEqual(x.PackedBool, y.PackedBool) {
 		return false
 	}
-	for i := 0; i < len(x.PackedBool); i++ {
-		if x.PackedBool[i] != y.PackedBool[i] {
-			return false
-		}
-	}
-	if len(x.PackedEnum) != len(y.PackedEnum) {
+	if !equal.SliceEqual(x.PackedEnum, y.PackedEnum) {
 		return false
 	}
-	for i := 0; i < len(x.PackedEnum); i++ {
-		if x.PackedEnum[i] != y.PackedEnum[i] {
-			return false
-		}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
 	}
 	return true
 }
@@ -1004,117 +826,50 @@ func (x *TestUnpackedTypes) Equal(y *TestUnpackedTypes) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if len(x.UnpackedInt32) != len(y.UnpackedInt32) {
+	if !equal.SliceEqual(x.UnpackedInt32, y.UnpackedInt32) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedInt32); i++ {
-		if x.UnpackedInt32[i] != y.UnpackedInt32[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedInt64) != len(y.UnpackedInt64) {
+	if !equal.SliceEqual(x.UnpackedInt64, y.UnpackedInt64) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedInt64); i++ {
-		if x.UnpackedInt64[i] != y.UnpackedInt64[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedUint32) != len(y.UnpackedUint32) {
+	if !equal.SliceEqual(x.UnpackedUint32, y.UnpackedUint32) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedUint32); i++ {
-		if x.UnpackedUint32[i] != y.UnpackedUint32[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedUint64) != len(y.UnpackedUint64) {
+	if !equal.SliceEqual(x.UnpackedUint64, y.UnpackedUint64) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedUint64); i++ {
-		if x.UnpackedUint64[i] != y.UnpackedUint64[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedSint32) != len(y.UnpackedSint32) {
+	if !equal.SliceEqual(x.UnpackedSint32, y.UnpackedSint32) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedSint32); i++ {
-		if x.UnpackedSint32[i] != y.UnpackedSint32[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedSint64) != len(y.UnpackedSint64) {
+	if !equal.SliceEqual(x.UnpackedSint64, y.UnpackedSint64) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedSint64); i++ {
-		if x.UnpackedSint64[i] != y.UnpackedSint64[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedFixed32) != len(y.UnpackedFixed32) {
+	if !equal.SliceEqual(x.UnpackedFixed32, y.UnpackedFixed32) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedFixed32); i++ {
-		if x.UnpackedFixed32[i] != y.UnpackedFixed32[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedFixed64) != len(y.UnpackedFixed64) {
+	if !equal.SliceEqual(x.UnpackedFixed64, y.UnpackedFixed64) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedFixed64); i++ {
-		if x.UnpackedFixed64[i] != y.UnpackedFixed64[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedSfixed32) != len(y.UnpackedSfixed32) {
+	if !equal.SliceEqual(x.UnpackedSfixed32, y.UnpackedSfixed32) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedSfixed32); i++ {
-		if x.UnpackedSfixed32[i] != y.UnpackedSfixed32[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedSfixed64) != len(y.UnpackedSfixed64) {
+	if !equal.SliceEqual(x.UnpackedSfixed64, y.UnpackedSfixed64) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedSfixed64); i++ {
-		if x.UnpackedSfixed64[i] != y.UnpackedSfixed64[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedFloat) != len(y.UnpackedFloat) {
+	if !equal.SliceEqualFunc(x.UnpackedFloat, y.UnpackedFloat, equal.FloatEqual[float32]) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedFloat); i++ {
-		if (math.IsNaN(float64(x.UnpackedFloat[i])) && !math.IsNaN(float64(y.UnpackedFloat[i])) || !math.IsNaN(float64(x.UnpackedFloat[i])) && math.IsNaN(float64(y.UnpackedFloat[i]))) || (!math.IsNaN(float64(x.UnpackedFloat[i])) && !math.IsNaN(float64(y.UnpackedFloat[i])) && x.UnpackedFloat[i] != y.UnpackedFloat[i]) {
-			return false
-		}
-	}
-	if len(x.UnpackedDouble) != len(y.UnpackedDouble) {
+	if !equal.SliceEqualFunc(x.UnpackedDouble, y.UnpackedDouble, equal.FloatEqual[float64]) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedDouble); i++ {
-		if (math.IsNaN(float64(x.UnpackedDouble[i])) && !math.IsNaN(float64(y.UnpackedDouble[i])) || !math.IsNaN(float64(x.UnpackedDouble[i])) && math.IsNaN(float64(y.UnpackedDouble[i]))) || (!math.IsNaN(float64(x.UnpackedDouble[i])) && !math.IsNaN(float64(y.UnpackedDouble[i])) && x.UnpackedDouble[i] != y.UnpackedDouble[i]) {
-			return false
-		}
-	}
-	if len(x.UnpackedBool) != len(y.UnpackedBool) {
+	if !equal.SliceEqual(x.UnpackedBool, y.UnpackedBool) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedBool); i++ {
-		if x.UnpackedBool[i] != y.UnpackedBool[i] {
-			return false
-		}
-	}
-	if len(x.UnpackedEnum) != len(y.UnpackedEnum) {
+	if !equal.SliceEqual(x.UnpackedEnum, y.UnpackedEnum) {
 		return false
 	}
-	for i := 0; i < len(x.UnpackedEnum); i++ {
-		if x.UnpackedEnum[i] != y.UnpackedEnum[i] {
-			return false
-		}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
 	}
 	return true
 }
@@ -1126,6 +881,12 @@ func (x *TestPackedExtensions) Equal(y *TestPackedExtensions) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.Extensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -1136,6 +897,12 @@ func (x *TestUnpackedExtensions) Equal(y *TestUnpackedExtensions) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.Extensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -1146,6 +913,9 @@ func (x *FooRequest) Equal(y *FooRequest) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -1156,7 +926,10 @@ func (x *FooResponse) Equal(y *FooResponse) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	return true
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
+	return true
 }
 
 func (x *WeirdDefault) Equal(y *WeirdDefault) bool {
@@ -1166,7 +939,10 @@ func (x *WeirdDefault) Equal(y *WeirdDefault) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.WeirdDefault, y.WeirdDefault; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q))) {
+	if !equal.OptionalBytesEqual(x.WeirdDefault, y.WeirdDefault) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -1179,29 +955,5676 @@ func (x *RemoteDefault) Equal(y *RemoteDefault) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.Default, y.Default; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Default, y.Default) {
+		return false
+	}
+	if !equal.OptionalEqual(x.Zero, y.Zero) {
 		return false
 	}
-	if p, q := x.Zero, y.Zero; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.One, y.One) {
 		return false
 	}
-	if p, q := x.One, y.One; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Elevent, y.Elevent) {
 		return false
 	}
-	if p, q := x.Elevent, y.Elevent; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Seventeen, y.Seventeen) {
 		return false
 	}
-	if p, q := x.Seventeen, y.Seventeen; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Thirtyseven, y.Thirtyseven) {
 		return false
 	}
-	if p, q := x.Thirtyseven, y.Thirtyseven; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Sixtyseven, y.Sixtyseven) {
 		return false
 	}
-	if p, q := x.Sixtyseven, y.Sixtyseven; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.Negative, y.Negative) {
 		return false
 	}
-	if p, q := x.Negative, y.Negative; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
 }
+
+func init() {
+	equal.Register("goproto.proto.test.TestAllTypes", (*TestAllTypes).Equal)
+	equal.Register("goproto.proto.test.TestAllTypes.NestedMessage", (*TestAllTypes_NestedMessage).Equal)
+	equal.Register("goproto.proto.test.TestAllTypes.OptionalGroup", (*TestAllTypes_OptionalGroup).Equal)
+	equal.Register("goproto.proto.test.TestAllTypes.RepeatedGroup", (*TestAllTypes_RepeatedGroup).Equal)
+	equal.Register("goproto.proto.test.TestAllTypes.OneofGroup", (*TestAllTypes_OneofGroup).Equal)
+	equal.Register("goproto.proto.test.TestDeprecatedMessage", (*TestDeprecatedMessage).Equal)
+	equal.Register("goproto.proto.test.ForeignMessage", (*ForeignMessage).Equal)
+	equal.Register("goproto.proto.test.TestReservedFields", (*TestReservedFields).Equal)
+	equal.Register("goproto.proto.test.TestAllExtensions", (*TestAllExtensions).Equal)
+	equal.Register("goproto.proto.test.TestAllExtensions.NestedMessage", (*TestAllExtensions_NestedMessage).Equal)
+	equal.Register("goproto.proto.test.OptionalGroup", (*OptionalGroup).Equal)
+	equal.Register("goproto.proto.test.RepeatedGroup", (*RepeatedGroup).Equal)
+	equal.Register("goproto.proto.test.TestNestedExtension", (*TestNestedExtension).Equal)
+	equal.Register("goproto.proto.test.TestRequired", (*TestRequired).Equal)
+	equal.Register("goproto.proto.test.TestRequiredForeign", (*TestRequiredForeign).Equal)
+	equal.Register("goproto.proto.test.TestRequiredGroupFields", (*TestRequiredGroupFields).Equal)
+	equal.Register("goproto.proto.test.TestRequiredGroupFields.OptionalGroup", (*TestRequiredGroupFields_OptionalGroup).Equal)
+	equal.Register("goproto.proto.test.TestRequiredGroupFields.RepeatedGroup", (*TestRequiredGroupFields_RepeatedGroup).Equal)
+	equal.Register("goproto.proto.test.TestWeak", (*TestWeak).Equal)
+	equal.Register("goproto.proto.test.TestPackedTypes", (*TestPackedTypes).Equal)
+	equal.Register("goproto.proto.test.TestUnpackedTypes", (*TestUnpackedTypes).Equal)
+	equal.Register("goproto.proto.test.TestPackedExtensions", (*TestPackedExtensions).Equal)
+	equal.Register("goproto.proto.test.TestUnpackedExtensions", (*TestUnpackedExtensions).Equal)
+	equal.Register("goproto.proto.test.FooRequest", (*FooRequest).Equal)
+	equal.Register("goproto.proto.test.FooResponse", (*FooResponse).Equal)
+	equal.Register("goproto.proto.test.WeirdDefault", (*WeirdDefault).Equal)
+	equal.Register("goproto.proto.test.RemoteDefault", (*RemoteDefault).Equal)
+}
+
+func (x *TestAllTypes_NestedMessage) Diff(y *TestAllTypes_NestedMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "corecursive", x.Corecursive.Diff(y.Corecursive))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes_OptionalGroup) Diff(y *TestAllTypes_OptionalGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
+		d = append(d, equal.Difference{Path: "same_field_number", X: x.SameFieldNumber, Y: y.SameFieldNumber})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes_RepeatedGroup) Diff(y *TestAllTypes_RepeatedGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes_OneofGroup) Diff(y *TestAllTypes_OneofGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	if !equal.OptionalEqual(x.B, y.B) {
+		d = append(d, equal.Difference{Path: "b", X: x.B, Y: y.B})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes) Diff(y *TestAllTypes) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
+		d = append(d, equal.Difference{Path: "optional_int32", X: x.OptionalInt32, Y: y.OptionalInt32})
+	}
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
+		d = append(d, equal.Difference{Path: "optional_int64", X: x.OptionalInt64, Y: y.OptionalInt64})
+	}
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
+		d = append(d, equal.Difference{Path: "optional_uint32", X: x.OptionalUint32, Y: y.OptionalUint32})
+	}
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
+		d = append(d, equal.Difference{Path: "optional_uint64", X: x.OptionalUint64, Y: y.OptionalUint64})
+	}
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
+		d = append(d, equal.Difference{Path: "optional_sint32", X: x.OptionalSint32, Y: y.OptionalSint32})
+	}
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
+		d = append(d, equal.Difference{Path: "optional_sint64", X: x.OptionalSint64, Y: y.OptionalSint64})
+	}
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
+		d = append(d, equal.Difference{Path: "optional_fixed32", X: x.OptionalFixed32, Y: y.OptionalFixed32})
+	}
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
+		d = append(d, equal.Difference{Path: "optional_fixed64", X: x.OptionalFixed64, Y: y.OptionalFixed64})
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
+		d = append(d, equal.Difference{Path: "optional_sfixed32", X: x.OptionalSfixed32, Y: y.OptionalSfixed32})
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
+		d = append(d, equal.Difference{Path: "optional_sfixed64", X: x.OptionalSfixed64, Y: y.OptionalSfixed64})
+	}
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
+		d = append(d, equal.Difference{Path: "optional_float", X: x.OptionalFloat, Y: y.OptionalFloat})
+	}
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
+		d = append(d, equal.Difference{Path: "optional_double", X: x.OptionalDouble, Y: y.OptionalDouble})
+	}
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
+		d = append(d, equal.Difference{Path: "optional_bool", X: x.OptionalBool, Y: y.OptionalBool})
+	}
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
+		d = append(d, equal.Difference{Path: "optional_string", X: x.OptionalString, Y: y.OptionalString})
+	}
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
+		d = append(d, equal.Difference{Path: "optional_bytes", X: x.OptionalBytes, Y: y.OptionalBytes})
+	}
+	d = equal.AppendNested(d, "optionalgroup", x.Optionalgroup.Diff(y.Optionalgroup))
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	d = equal.AppendNested(d, "optional_foreign_message", x.OptionalForeignMessage.Diff(y.OptionalForeignMessage))
+	d = equal.AppendNested(d, "optional_import_message", x.OptionalImportMessage.Diff(y.OptionalImportMessage))
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
+		d = append(d, equal.Difference{Path: "optional_nested_enum", X: x.OptionalNestedEnum, Y: y.OptionalNestedEnum})
+	}
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
+		d = append(d, equal.Difference{Path: "optional_foreign_enum", X: x.OptionalForeignEnum, Y: y.OptionalForeignEnum})
+	}
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
+		d = append(d, equal.Difference{Path: "optional_import_enum", X: x.OptionalImportEnum, Y: y.OptionalImportEnum})
+	}
+	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+		d = append(d, equal.Difference{Path: "repeated_int32", X: x.RepeatedInt32, Y: y.RepeatedInt32})
+	} else {
+		for i := 0; i < len(x.RepeatedInt32); i++ {
+			if x.RepeatedInt32[i] != y.RepeatedInt32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_int32", i), X: x.RepeatedInt32[i], Y: y.RepeatedInt32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+		d = append(d, equal.Difference{Path: "repeated_int64", X: x.RepeatedInt64, Y: y.RepeatedInt64})
+	} else {
+		for i := 0; i < len(x.RepeatedInt64); i++ {
+			if x.RepeatedInt64[i] != y.RepeatedInt64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_int64", i), X: x.RepeatedInt64[i], Y: y.RepeatedInt64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+		d = append(d, equal.Difference{Path: "repeated_uint32", X: x.RepeatedUint32, Y: y.RepeatedUint32})
+	} else {
+		for i := 0; i < len(x.RepeatedUint32); i++ {
+			if x.RepeatedUint32[i] != y.RepeatedUint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_uint32", i), X: x.RepeatedUint32[i], Y: y.RepeatedUint32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+		d = append(d, equal.Difference{Path: "repeated_uint64", X: x.RepeatedUint64, Y: y.RepeatedUint64})
+	} else {
+		for i := 0; i < len(x.RepeatedUint64); i++ {
+			if x.RepeatedUint64[i] != y.RepeatedUint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_uint64", i), X: x.RepeatedUint64[i], Y: y.RepeatedUint64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+		d = append(d, equal.Difference{Path: "repeated_sint32", X: x.RepeatedSint32, Y: y.RepeatedSint32})
+	} else {
+		for i := 0; i < len(x.RepeatedSint32); i++ {
+			if x.RepeatedSint32[i] != y.RepeatedSint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sint32", i), X: x.RepeatedSint32[i], Y: y.RepeatedSint32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+		d = append(d, equal.Difference{Path: "repeated_sint64", X: x.RepeatedSint64, Y: y.RepeatedSint64})
+	} else {
+		for i := 0; i < len(x.RepeatedSint64); i++ {
+			if x.RepeatedSint64[i] != y.RepeatedSint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sint64", i), X: x.RepeatedSint64[i], Y: y.RepeatedSint64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+		d = append(d, equal.Difference{Path: "repeated_fixed32", X: x.RepeatedFixed32, Y: y.RepeatedFixed32})
+	} else {
+		for i := 0; i < len(x.RepeatedFixed32); i++ {
+			if x.RepeatedFixed32[i] != y.RepeatedFixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_fixed32", i), X: x.RepeatedFixed32[i], Y: y.RepeatedFixed32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+		d = append(d, equal.Difference{Path: "repeated_fixed64", X: x.RepeatedFixed64, Y: y.RepeatedFixed64})
+	} else {
+		for i := 0; i < len(x.RepeatedFixed64); i++ {
+			if x.RepeatedFixed64[i] != y.RepeatedFixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_fixed64", i), X: x.RepeatedFixed64[i], Y: y.RepeatedFixed64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+		d = append(d, equal.Difference{Path: "repeated_sfixed32", X: x.RepeatedSfixed32, Y: y.RepeatedSfixed32})
+	} else {
+		for i := 0; i < len(x.RepeatedSfixed32); i++ {
+			if x.RepeatedSfixed32[i] != y.RepeatedSfixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sfixed32", i), X: x.RepeatedSfixed32[i], Y: y.RepeatedSfixed32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+		d = append(d, equal.Difference{Path: "repeated_sfixed64", X: x.RepeatedSfixed64, Y: y.RepeatedSfixed64})
+	} else {
+		for i := 0; i < len(x.RepeatedSfixed64); i++ {
+			if x.RepeatedSfixed64[i] != y.RepeatedSfixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sfixed64", i), X: x.RepeatedSfixed64[i], Y: y.RepeatedSfixed64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+		d = append(d, equal.Difference{Path: "repeated_float", X: x.RepeatedFloat, Y: y.RepeatedFloat})
+	} else {
+		for i := 0; i < len(x.RepeatedFloat); i++ {
+			if !equal.FloatEqual(x.RepeatedFloat[i], y.RepeatedFloat[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_float", i), X: x.RepeatedFloat[i], Y: y.RepeatedFloat[i]})
+			}
+		}
+	}
+	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+		d = append(d, equal.Difference{Path: "repeated_double", X: x.RepeatedDouble, Y: y.RepeatedDouble})
+	} else {
+		for i := 0; i < len(x.RepeatedDouble); i++ {
+			if !equal.FloatEqual(x.RepeatedDouble[i], y.RepeatedDouble[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_double", i), X: x.RepeatedDouble[i], Y: y.RepeatedDouble[i]})
+			}
+		}
+	}
+	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+		d = append(d, equal.Difference{Path: "repeated_bool", X: x.RepeatedBool, Y: y.RepeatedBool})
+	} else {
+		for i := 0; i < len(x.RepeatedBool); i++ {
+			if x.RepeatedBool[i] != y.RepeatedBool[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_bool", i), X: x.RepeatedBool[i], Y: y.RepeatedBool[i]})
+			}
+		}
+	}
+	if len(x.RepeatedString) != len(y.RepeatedString) {
+		d = append(d, equal.Difference{Path: "repeated_string", X: x.RepeatedString, Y: y.RepeatedString})
+	} else {
+		for i := 0; i < len(x.RepeatedString); i++ {
+			if x.RepeatedString[i] != y.RepeatedString[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_string", i), X: x.RepeatedString[i], Y: y.RepeatedString[i]})
+			}
+		}
+	}
+	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+		d = append(d, equal.Difference{Path: "repeated_bytes", X: x.RepeatedBytes, Y: y.RepeatedBytes})
+	} else {
+		for i := 0; i < len(x.RepeatedBytes); i++ {
+			if string(x.RepeatedBytes[i]) != string(y.RepeatedBytes[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_bytes", i), X: x.RepeatedBytes[i], Y: y.RepeatedBytes[i]})
+			}
+		}
+	}
+	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+		d = append(d, equal.Difference{Path: "repeatedgroup", X: x.Repeatedgroup, Y: y.Repeatedgroup})
+	} else {
+		for i := 0; i < len(x.Repeatedgroup); i++ {
+			d = equal.AppendNested(d, equal.Index("repeatedgroup", i), x.Repeatedgroup[i].Diff(y.Repeatedgroup[i]))
+		}
+	}
+	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+		d = append(d, equal.Difference{Path: "repeated_nested_message", X: x.RepeatedNestedMessage, Y: y.RepeatedNestedMessage})
+	} else {
+		for i := 0; i < len(x.RepeatedNestedMessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_nested_message", i), x.RepeatedNestedMessage[i].Diff(y.RepeatedNestedMessage[i]))
+		}
+	}
+	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+		d = append(d, equal.Difference{Path: "repeated_foreign_message", X: x.RepeatedForeignMessage, Y: y.RepeatedForeignMessage})
+	} else {
+		for i := 0; i < len(x.RepeatedForeignMessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_foreign_message", i), x.RepeatedForeignMessage[i].Diff(y.RepeatedForeignMessage[i]))
+		}
+	}
+	if len(x.RepeatedImportmessage) != len(y.RepeatedImportmessage) {
+		d = append(d, equal.Difference{Path: "repeated_importmessage", X: x.RepeatedImportmessage, Y: y.RepeatedImportmessage})
+	} else {
+		for i := 0; i < len(x.RepeatedImportmessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_importmessage", i), x.RepeatedImportmessage[i].Diff(y.RepeatedImportmessage[i]))
+		}
+	}
+	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+		d = append(d, equal.Difference{Path: "repeated_nested_enum", X: x.RepeatedNestedEnum, Y: y.RepeatedNestedEnum})
+	} else {
+		for i := 0; i < len(x.RepeatedNestedEnum); i++ {
+			if x.RepeatedNestedEnum[i] != y.RepeatedNestedEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_nested_enum", i), X: x.RepeatedNestedEnum[i], Y: y.RepeatedNestedEnum[i]})
+			}
+		}
+	}
+	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+		d = append(d, equal.Difference{Path: "repeated_foreign_enum", X: x.RepeatedForeignEnum, Y: y.RepeatedForeignEnum})
+	} else {
+		for i := 0; i < len(x.RepeatedForeignEnum); i++ {
+			if x.RepeatedForeignEnum[i] != y.RepeatedForeignEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_foreign_enum", i), X: x.RepeatedForeignEnum[i], Y: y.RepeatedForeignEnum[i]})
+			}
+		}
+	}
+	if len(x.RepeatedImportenum) != len(y.RepeatedImportenum) {
+		d = append(d, equal.Difference{Path: "repeated_importenum", X: x.RepeatedImportenum, Y: y.RepeatedImportenum})
+	} else {
+		for i := 0; i < len(x.RepeatedImportenum); i++ {
+			if x.RepeatedImportenum[i] != y.RepeatedImportenum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_importenum", i), X: x.RepeatedImportenum[i], Y: y.RepeatedImportenum[i]})
+			}
+		}
+	}
+	for k := range x.MapInt32Int32 {
+		if _, ok := y.MapInt32Int32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), X: x.MapInt32Int32[k]})
+			continue
+		}
+		if x.MapInt32Int32[k] != y.MapInt32Int32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), X: x.MapInt32Int32[k], Y: y.MapInt32Int32[k]})
+		}
+	}
+	for k := range y.MapInt32Int32 {
+		if _, ok := x.MapInt32Int32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), Y: y.MapInt32Int32[k]})
+		}
+	}
+	for k := range x.MapInt64Int64 {
+		if _, ok := y.MapInt64Int64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), X: x.MapInt64Int64[k]})
+			continue
+		}
+		if x.MapInt64Int64[k] != y.MapInt64Int64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), X: x.MapInt64Int64[k], Y: y.MapInt64Int64[k]})
+		}
+	}
+	for k := range y.MapInt64Int64 {
+		if _, ok := x.MapInt64Int64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), Y: y.MapInt64Int64[k]})
+		}
+	}
+	for k := range x.MapUint32Uint32 {
+		if _, ok := y.MapUint32Uint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), X: x.MapUint32Uint32[k]})
+			continue
+		}
+		if x.MapUint32Uint32[k] != y.MapUint32Uint32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), X: x.MapUint32Uint32[k], Y: y.MapUint32Uint32[k]})
+		}
+	}
+	for k := range y.MapUint32Uint32 {
+		if _, ok := x.MapUint32Uint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), Y: y.MapUint32Uint32[k]})
+		}
+	}
+	for k := range x.MapUint64Uint64 {
+		if _, ok := y.MapUint64Uint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), X: x.MapUint64Uint64[k]})
+			continue
+		}
+		if x.MapUint64Uint64[k] != y.MapUint64Uint64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), X: x.MapUint64Uint64[k], Y: y.MapUint64Uint64[k]})
+		}
+	}
+	for k := range y.MapUint64Uint64 {
+		if _, ok := x.MapUint64Uint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), Y: y.MapUint64Uint64[k]})
+		}
+	}
+	for k := range x.MapSint32Sint32 {
+		if _, ok := y.MapSint32Sint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), X: x.MapSint32Sint32[k]})
+			continue
+		}
+		if x.MapSint32Sint32[k] != y.MapSint32Sint32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), X: x.MapSint32Sint32[k], Y: y.MapSint32Sint32[k]})
+		}
+	}
+	for k := range y.MapSint32Sint32 {
+		if _, ok := x.MapSint32Sint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), Y: y.MapSint32Sint32[k]})
+		}
+	}
+	for k := range x.MapSint64Sint64 {
+		if _, ok := y.MapSint64Sint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), X: x.MapSint64Sint64[k]})
+			continue
+		}
+		if x.MapSint64Sint64[k] != y.MapSint64Sint64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), X: x.MapSint64Sint64[k], Y: y.MapSint64Sint64[k]})
+		}
+	}
+	for k := range y.MapSint64Sint64 {
+		if _, ok := x.MapSint64Sint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), Y: y.MapSint64Sint64[k]})
+		}
+	}
+	for k := range x.MapFixed32Fixed32 {
+		if _, ok := y.MapFixed32Fixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), X: x.MapFixed32Fixed32[k]})
+			continue
+		}
+		if x.MapFixed32Fixed32[k] != y.MapFixed32Fixed32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), X: x.MapFixed32Fixed32[k], Y: y.MapFixed32Fixed32[k]})
+		}
+	}
+	for k := range y.MapFixed32Fixed32 {
+		if _, ok := x.MapFixed32Fixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), Y: y.MapFixed32Fixed32[k]})
+		}
+	}
+	for k := range x.MapFixed64Fixed64 {
+		if _, ok := y.MapFixed64Fixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), X: x.MapFixed64Fixed64[k]})
+			continue
+		}
+		if x.MapFixed64Fixed64[k] != y.MapFixed64Fixed64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), X: x.MapFixed64Fixed64[k], Y: y.MapFixed64Fixed64[k]})
+		}
+	}
+	for k := range y.MapFixed64Fixed64 {
+		if _, ok := x.MapFixed64Fixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), Y: y.MapFixed64Fixed64[k]})
+		}
+	}
+	for k := range x.MapSfixed32Sfixed32 {
+		if _, ok := y.MapSfixed32Sfixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), X: x.MapSfixed32Sfixed32[k]})
+			continue
+		}
+		if x.MapSfixed32Sfixed32[k] != y.MapSfixed32Sfixed32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), X: x.MapSfixed32Sfixed32[k], Y: y.MapSfixed32Sfixed32[k]})
+		}
+	}
+	for k := range y.MapSfixed32Sfixed32 {
+		if _, ok := x.MapSfixed32Sfixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), Y: y.MapSfixed32Sfixed32[k]})
+		}
+	}
+	for k := range x.MapSfixed64Sfixed64 {
+		if _, ok := y.MapSfixed64Sfixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), X: x.MapSfixed64Sfixed64[k]})
+			continue
+		}
+		if x.MapSfixed64Sfixed64[k] != y.MapSfixed64Sfixed64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), X: x.MapSfixed64Sfixed64[k], Y: y.MapSfixed64Sfixed64[k]})
+		}
+	}
+	for k := range y.MapSfixed64Sfixed64 {
+		if _, ok := x.MapSfixed64Sfixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), Y: y.MapSfixed64Sfixed64[k]})
+		}
+	}
+	for k := range x.MapInt32Float {
+		if _, ok := y.MapInt32Float[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), X: x.MapInt32Float[k]})
+			continue
+		}
+		if !equal.FloatEqual(x.MapInt32Float[k], y.MapInt32Float[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), X: x.MapInt32Float[k], Y: y.MapInt32Float[k]})
+		}
+	}
+	for k := range y.MapInt32Float {
+		if _, ok := x.MapInt32Float[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), Y: y.MapInt32Float[k]})
+		}
+	}
+	for k := range x.MapInt32Double {
+		if _, ok := y.MapInt32Double[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), X: x.MapInt32Double[k]})
+			continue
+		}
+		if !equal.FloatEqual(x.MapInt32Double[k], y.MapInt32Double[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), X: x.MapInt32Double[k], Y: y.MapInt32Double[k]})
+		}
+	}
+	for k := range y.MapInt32Double {
+		if _, ok := x.MapInt32Double[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), Y: y.MapInt32Double[k]})
+		}
+	}
+	for k := range x.MapBoolBool {
+		if _, ok := y.MapBoolBool[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), X: x.MapBoolBool[k]})
+			continue
+		}
+		if x.MapBoolBool[k] != y.MapBoolBool[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), X: x.MapBoolBool[k], Y: y.MapBoolBool[k]})
+		}
+	}
+	for k := range y.MapBoolBool {
+		if _, ok := x.MapBoolBool[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), Y: y.MapBoolBool[k]})
+		}
+	}
+	for k := range x.MapStringString {
+		if _, ok := y.MapStringString[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), X: x.MapStringString[k]})
+			continue
+		}
+		if x.MapStringString[k] != y.MapStringString[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), X: x.MapStringString[k], Y: y.MapStringString[k]})
+		}
+	}
+	for k := range y.MapStringString {
+		if _, ok := x.MapStringString[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), Y: y.MapStringString[k]})
+		}
+	}
+	for k := range x.MapStringBytes {
+		if _, ok := y.MapStringBytes[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), X: x.MapStringBytes[k]})
+			continue
+		}
+		if string(x.MapStringBytes[k]) != string(y.MapStringBytes[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), X: x.MapStringBytes[k], Y: y.MapStringBytes[k]})
+		}
+	}
+	for k := range y.MapStringBytes {
+		if _, ok := x.MapStringBytes[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), Y: y.MapStringBytes[k]})
+		}
+	}
+	for k := range x.MapStringNestedMessage {
+		if _, ok := y.MapStringNestedMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_message", k), X: x.MapStringNestedMessage[k]})
+			continue
+		}
+		d = equal.AppendNested(d, equal.Key("map_string_nested_message", k), x.MapStringNestedMessage[k].Diff(y.MapStringNestedMessage[k]))
+	}
+	for k := range y.MapStringNestedMessage {
+		if _, ok := x.MapStringNestedMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_message", k), Y: y.MapStringNestedMessage[k]})
+		}
+	}
+	for k := range x.MapStringNestedEnum {
+		if _, ok := y.MapStringNestedEnum[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), X: x.MapStringNestedEnum[k]})
+			continue
+		}
+		if x.MapStringNestedEnum[k] != y.MapStringNestedEnum[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), X: x.MapStringNestedEnum[k], Y: y.MapStringNestedEnum[k]})
+		}
+	}
+	for k := range y.MapStringNestedEnum {
+		if _, ok := x.MapStringNestedEnum[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), Y: y.MapStringNestedEnum[k]})
+		}
+	}
+	if !equal.OptionalEqual(x.DefaultInt32, y.DefaultInt32) {
+		d = append(d, equal.Difference{Path: "default_int32", X: x.DefaultInt32, Y: y.DefaultInt32})
+	}
+	if !equal.OptionalEqual(x.DefaultInt64, y.DefaultInt64) {
+		d = append(d, equal.Difference{Path: "default_int64", X: x.DefaultInt64, Y: y.DefaultInt64})
+	}
+	if !equal.OptionalEqual(x.DefaultUint32, y.DefaultUint32) {
+		d = append(d, equal.Difference{Path: "default_uint32", X: x.DefaultUint32, Y: y.DefaultUint32})
+	}
+	if !equal.OptionalEqual(x.DefaultUint64, y.DefaultUint64) {
+		d = append(d, equal.Difference{Path: "default_uint64", X: x.DefaultUint64, Y: y.DefaultUint64})
+	}
+	if !equal.OptionalEqual(x.DefaultSint32, y.DefaultSint32) {
+		d = append(d, equal.Difference{Path: "default_sint32", X: x.DefaultSint32, Y: y.DefaultSint32})
+	}
+	if !equal.OptionalEqual(x.DefaultSint64, y.DefaultSint64) {
+		d = append(d, equal.Difference{Path: "default_sint64", X: x.DefaultSint64, Y: y.DefaultSint64})
+	}
+	if !equal.OptionalEqual(x.DefaultFixed32, y.DefaultFixed32) {
+		d = append(d, equal.Difference{Path: "default_fixed32", X: x.DefaultFixed32, Y: y.DefaultFixed32})
+	}
+	if !equal.OptionalEqual(x.DefaultFixed64, y.DefaultFixed64) {
+		d = append(d, equal.Difference{Path: "default_fixed64", X: x.DefaultFixed64, Y: y.DefaultFixed64})
+	}
+	if !equal.OptionalEqual(x.DefaultSfixed32, y.DefaultSfixed32) {
+		d = append(d, equal.Difference{Path: "default_sfixed32", X: x.DefaultSfixed32, Y: y.DefaultSfixed32})
+	}
+	if !equal.OptionalEqual(x.DefaultSfixed64, y.DefaultSfixed64) {
+		d = append(d, equal.Difference{Path: "default_sfixed64", X: x.DefaultSfixed64, Y: y.DefaultSfixed64})
+	}
+	if !equal.OptionalFloatEqual(x.DefaultFloat, y.DefaultFloat) {
+		d = append(d, equal.Difference{Path: "default_float", X: x.DefaultFloat, Y: y.DefaultFloat})
+	}
+	if !equal.OptionalFloatEqual(x.DefaultDouble, y.DefaultDouble) {
+		d = append(d, equal.Difference{Path: "default_double", X: x.DefaultDouble, Y: y.DefaultDouble})
+	}
+	if !equal.OptionalEqual(x.DefaultBool, y.DefaultBool) {
+		d = append(d, equal.Difference{Path: "default_bool", X: x.DefaultBool, Y: y.DefaultBool})
+	}
+	if !equal.OptionalEqual(x.DefaultString, y.DefaultString) {
+		d = append(d, equal.Difference{Path: "default_string", X: x.DefaultString, Y: y.DefaultString})
+	}
+	if !equal.OptionalBytesEqual(x.DefaultBytes, y.DefaultBytes) {
+		d = append(d, equal.Difference{Path: "default_bytes", X: x.DefaultBytes, Y: y.DefaultBytes})
+	}
+	if !equal.OptionalEqual(x.DefaultNestedEnum, y.DefaultNestedEnum) {
+		d = append(d, equal.Difference{Path: "default_nested_enum", X: x.DefaultNestedEnum, Y: y.DefaultNestedEnum})
+	}
+	if !equal.OptionalEqual(x.DefaultForeignEnum, y.DefaultForeignEnum) {
+		d = append(d, equal.Difference{Path: "default_foreign_enum", X: x.DefaultForeignEnum, Y: y.DefaultForeignEnum})
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			d = append(d, equal.Difference{Path: "oneof_field", Y: y.OneofField})
+		}
+	case *TestAllTypes_OneofUint32:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofUint32 != yv.OneofUint32 {
+				d = append(d, equal.Difference{Path: "oneof_uint32", X: xv.OneofUint32, Y: yv.OneofUint32})
+			}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			d = equal.AppendNested(d, "oneof_nested_message", xv.OneofNestedMessage.Diff(yv.OneofNestedMessage))
+		}
+	case *TestAllTypes_OneofString:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofString); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofString != yv.OneofString {
+				d = append(d, equal.Difference{Path: "oneof_string", X: xv.OneofString, Y: yv.OneofString})
+			}
+		}
+	case *TestAllTypes_OneofBytes:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if string(xv.OneofBytes) != string(yv.OneofBytes) {
+				d = append(d, equal.Difference{Path: "oneof_bytes", X: xv.OneofBytes, Y: yv.OneofBytes})
+			}
+		}
+	case *TestAllTypes_OneofBool:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofBool != yv.OneofBool {
+				d = append(d, equal.Difference{Path: "oneof_bool", X: xv.OneofBool, Y: yv.OneofBool})
+			}
+		}
+	case *TestAllTypes_OneofUint64:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofUint64 != yv.OneofUint64 {
+				d = append(d, equal.Difference{Path: "oneof_uint64", X: xv.OneofUint64, Y: yv.OneofUint64})
+			}
+		}
+	case *TestAllTypes_OneofFloat:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
+				d = append(d, equal.Difference{Path: "oneof_float", X: xv.OneofFloat, Y: yv.OneofFloat})
+			}
+		}
+	case *TestAllTypes_OneofDouble:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
+				d = append(d, equal.Difference{Path: "oneof_double", X: xv.OneofDouble, Y: yv.OneofDouble})
+			}
+		}
+	case *TestAllTypes_OneofEnum:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofEnum != yv.OneofEnum {
+				d = append(d, equal.Difference{Path: "oneof_enum", X: xv.OneofEnum, Y: yv.OneofEnum})
+			}
+		}
+	case *TestAllTypes_Oneofgroup:
+		if yv, ok := y.OneofField.(*TestAllTypes_Oneofgroup); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			d = equal.AppendNested(d, "oneofgroup", xv.Oneofgroup.Diff(yv.Oneofgroup))
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+				d = append(d, equal.Difference{Path: "oneof_wrappers_string_value", X: xv.OneofWrappersStringValue, Y: yv.OneofWrappersStringValue})
+			}
+		}
+	}
+	switch xv := x.OneofOptional.(type) {
+	case nil:
+		if y.OneofOptional != nil {
+			d = append(d, equal.Difference{Path: "oneof_optional", Y: y.OneofOptional})
+		}
+	case *TestAllTypes_OneofOptionalUint32:
+		if yv, ok := y.OneofOptional.(*TestAllTypes_OneofOptionalUint32); !ok {
+			d = append(d, equal.Difference{Path: "oneof_optional", X: x.OneofOptional, Y: y.OneofOptional})
+		} else {
+			if xv.OneofOptionalUint32 != yv.OneofOptionalUint32 {
+				d = append(d, equal.Difference{Path: "oneof_optional_uint32", X: xv.OneofOptionalUint32, Y: yv.OneofOptionalUint32})
+			}
+		}
+	}
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
+		d = append(d, equal.Difference{Path: "any", X: x.Any, Y: y.Any})
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		d = append(d, equal.Difference{Path: "duration", X: x.Duration, Y: y.Duration})
+	}
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
+		d = append(d, equal.Difference{Path: "empty", X: x.Empty, Y: y.Empty})
+	}
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		d = append(d, equal.Difference{Path: "timestamp", X: x.Timestamp, Y: y.Timestamp})
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_bool_value", X: x.WrappersBoolValue, Y: y.WrappersBoolValue})
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_bytes_value", X: x.WrappersBytesValue, Y: y.WrappersBytesValue})
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_double_value", X: x.WrappersDoubleValue, Y: y.WrappersDoubleValue})
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_float_value", X: x.WrappersFloatValue, Y: y.WrappersFloatValue})
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_int32_value", X: x.WrappersInt32Value, Y: y.WrappersInt32Value})
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_int64_value", X: x.WrappersInt64Value, Y: y.WrappersInt64Value})
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_string_value", X: x.WrappersStringValue, Y: y.WrappersStringValue})
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_uint32_value", X: x.WrappersUint32Value, Y: y.WrappersUint32Value})
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_uint64_value", X: x.WrappersUint64Value, Y: y.WrappersUint64Value})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestDeprecatedMessage) Diff(y *TestDeprecatedMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.DeprecatedInt32, y.DeprecatedInt32) {
+		d = append(d, equal.Difference{Path: "deprecated_int32", X: x.DeprecatedInt32, Y: y.DeprecatedInt32})
+	}
+	switch xv := x.DeprecatedOneof.(type) {
+	case nil:
+		if y.DeprecatedOneof != nil {
+			d = append(d, equal.Difference{Path: "deprecated_oneof", Y: y.DeprecatedOneof})
+		}
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		if yv, ok := y.DeprecatedOneof.(*TestDeprecatedMessage_DeprecatedOneofField); !ok {
+			d = append(d, equal.Difference{Path: "deprecated_oneof", X: x.DeprecatedOneof, Y: y.DeprecatedOneof})
+		} else {
+			if xv.DeprecatedOneofField != yv.DeprecatedOneofField {
+				d = append(d, equal.Difference{Path: "deprecated_oneof_field", X: xv.DeprecatedOneofField, Y: yv.DeprecatedOneofField})
+			}
+		}
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *ForeignMessage) Diff(y *ForeignMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.C, y.C) {
+		d = append(d, equal.Difference{Path: "c", X: x.C, Y: y.C})
+	}
+	if !equal.OptionalEqual(x.D, y.D) {
+		d = append(d, equal.Difference{Path: "d", X: x.D, Y: y.D})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestReservedFields) Diff(y *TestReservedFields) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllExtensions_NestedMessage) Diff(y *TestAllExtensions_NestedMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "corecursive", x.Corecursive.Diff(y.Corecursive))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllExtensions) Diff(y *TestAllExtensions) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	d = append(d, equal.DiffExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, func(p, q protoreflect.Message) bool {
+		switch v := p.Interface().(type) {
+		case *OptionalGroup:
+			return v.Equal(q.Interface().(*OptionalGroup))
+		case *TestAllExtensions_NestedMessage:
+			return v.Equal(q.Interface().(*TestAllExtensions_NestedMessage))
+		case *RepeatedGroup:
+			return v.Equal(q.Interface().(*RepeatedGroup))
+		case *TestRequired:
+			return v.Equal(q.Interface().(*TestRequired))
+		}
+		return proto.Equal(p.Interface(), q.Interface())
+	})...)
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *OptionalGroup) Diff(y *OptionalGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
+		d = append(d, equal.Difference{Path: "same_field_number", X: x.SameFieldNumber, Y: y.SameFieldNumber})
+	}
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *RepeatedGroup) Diff(y *RepeatedGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestNestedExtension) Diff(y *TestNestedExtension) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestRequired) Diff(y *TestRequired) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.RequiredField, y.RequiredField) {
+		d = append(d, equal.Difference{Path: "required_field", X: x.RequiredField, Y: y.RequiredField})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestRequiredForeign) Diff(y *TestRequiredForeign) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	d = equal.AppendNested(d, "optional_message", x.OptionalMessage.Diff(y.OptionalMessage))
+	if len(x.RepeatedMessage) != len(y.RepeatedMessage) {
+		d = append(d, equal.Difference{Path: "repeated_message", X: x.RepeatedMessage, Y: y.RepeatedMessage})
+	} else {
+		for i := 0; i < len(x.RepeatedMessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_message", i), x.RepeatedMessage[i].Diff(y.RepeatedMessage[i]))
+		}
+	}
+	for k := range x.MapMessage {
+		if _, ok := y.MapMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_message", k), X: x.MapMessage[k]})
+			continue
+		}
+		d = equal.AppendNested(d, equal.Key("map_message", k), x.MapMessage[k].Diff(y.MapMessage[k]))
+	}
+	for k := range y.MapMessage {
+		if _, ok := x.MapMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_message", k), Y: y.MapMessage[k]})
+		}
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			d = append(d, equal.Difference{Path: "oneof_field", Y: y.OneofField})
+		}
+	case *TestRequiredForeign_OneofMessage:
+		if yv, ok := y.OneofField.(*TestRequiredForeign_OneofMessage); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			d = equal.AppendNested(d, "oneof_message", xv.OneofMessage.Diff(yv.OneofMessage))
+		}
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) Diff(y *TestRequiredGroupFields_OptionalGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) Diff(y *TestRequiredGroupFields_RepeatedGroup) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestRequiredGroupFields) Diff(y *TestRequiredGroupFields) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	d = equal.AppendNested(d, "optionalgroup", x.Optionalgroup.Diff(y.Optionalgroup))
+	if len(x.Repeatedgroup) != len(y.Repeatedgroup) {
+		d = append(d, equal.Difference{Path: "repeatedgroup", X: x.Repeatedgroup, Y: y.Repeatedgroup})
+	} else {
+		for i := 0; i < len(x.Repeatedgroup); i++ {
+			d = equal.AppendNested(d, equal.Index("repeatedgroup", i), x.Repeatedgroup[i].Diff(y.Repeatedgroup[i]))
+		}
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestWeak) Diff(y *TestWeak) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestPackedTypes) Diff(y *TestPackedTypes) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if len(x.PackedInt32) != len(y.PackedInt32) {
+		d = append(d, equal.Difference{Path: "packed_int32", X: x.PackedInt32, Y: y.PackedInt32})
+	} else {
+		for i := 0; i < len(x.PackedInt32); i++ {
+			if x.PackedInt32[i] != y.PackedInt32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_int32", i), X: x.PackedInt32[i], Y: y.PackedInt32[i]})
+			}
+		}
+	}
+	if len(x.PackedInt64) != len(y.PackedInt64) {
+		d = append(d, equal.Difference{Path: "packed_int64", X: x.PackedInt64, Y: y.PackedInt64})
+	} else {
+		for i := 0; i < len(x.PackedInt64); i++ {
+			if x.PackedInt64[i] != y.PackedInt64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_int64", i), X: x.PackedInt64[i], Y: y.PackedInt64[i]})
+			}
+		}
+	}
+	if len(x.PackedUint32) != len(y.PackedUint32) {
+		d = append(d, equal.Difference{Path: "packed_uint32", X: x.PackedUint32, Y: y.PackedUint32})
+	} else {
+		for i := 0; i < len(x.PackedUint32); i++ {
+			if x.PackedUint32[i] != y.PackedUint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_uint32", i), X: x.PackedUint32[i], Y: y.PackedUint32[i]})
+			}
+		}
+	}
+	if len(x.PackedUint64) != len(y.PackedUint64) {
+		d = append(d, equal.Difference{Path: "packed_uint64", X: x.PackedUint64, Y: y.PackedUint64})
+	} else {
+		for i := 0; i < len(x.PackedUint64); i++ {
+			if x.PackedUint64[i] != y.PackedUint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_uint64", i), X: x.PackedUint64[i], Y: y.PackedUint64[i]})
+			}
+		}
+	}
+	if len(x.PackedSint32) != len(y.PackedSint32) {
+		d = append(d, equal.Difference{Path: "packed_sint32", X: x.PackedSint32, Y: y.PackedSint32})
+	} else {
+		for i := 0; i < len(x.PackedSint32); i++ {
+			if x.PackedSint32[i] != y.PackedSint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_sint32", i), X: x.PackedSint32[i], Y: y.PackedSint32[i]})
+			}
+		}
+	}
+	if len(x.PackedSint64) != len(y.PackedSint64) {
+		d = append(d, equal.Difference{Path: "packed_sint64", X: x.PackedSint64, Y: y.PackedSint64})
+	} else {
+		for i := 0; i < len(x.PackedSint64); i++ {
+			if x.PackedSint64[i] != y.PackedSint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_sint64", i), X: x.PackedSint64[i], Y: y.PackedSint64[i]})
+			}
+		}
+	}
+	if len(x.PackedFixed32) != len(y.PackedFixed32) {
+		d = append(d, equal.Difference{Path: "packed_fixed32", X: x.PackedFixed32, Y: y.PackedFixed32})
+	} else {
+		for i := 0; i < len(x.PackedFixed32); i++ {
+			if x.PackedFixed32[i] != y.PackedFixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_fixed32", i), X: x.PackedFixed32[i], Y: y.PackedFixed32[i]})
+			}
+		}
+	}
+	if len(x.PackedFixed64) != len(y.PackedFixed64) {
+		d = append(d, equal.Difference{Path: "packed_fixed64", X: x.PackedFixed64, Y: y.PackedFixed64})
+	} else {
+		for i := 0; i < len(x.PackedFixed64); i++ {
+			if x.PackedFixed64[i] != y.PackedFixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_fixed64", i), X: x.PackedFixed64[i], Y: y.PackedFixed64[i]})
+			}
+		}
+	}
+	if len(x.PackedSfixed32) != len(y.PackedSfixed32) {
+		d = append(d, equal.Difference{Path: "packed_sfixed32", X: x.PackedSfixed32, Y: y.PackedSfixed32})
+	} else {
+		for i := 0; i < len(x.PackedSfixed32); i++ {
+			if x.PackedSfixed32[i] != y.PackedSfixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_sfixed32", i), X: x.PackedSfixed32[i], Y: y.PackedSfixed32[i]})
+			}
+		}
+	}
+	if len(x.PackedSfixed64) != len(y.PackedSfixed64) {
+		d = append(d, equal.Difference{Path: "packed_sfixed64", X: x.PackedSfixed64, Y: y.PackedSfixed64})
+	} else {
+		for i := 0; i < len(x.PackedSfixed64); i++ {
+			if x.PackedSfixed64[i] != y.PackedSfixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_sfixed64", i), X: x.PackedSfixed64[i], Y: y.PackedSfixed64[i]})
+			}
+		}
+	}
+	if len(x.PackedFloat) != len(y.PackedFloat) {
+		d = append(d, equal.Difference{Path: "packed_float", X: x.PackedFloat, Y: y.PackedFloat})
+	} else {
+		for i := 0; i < len(x.PackedFloat); i++ {
+			if !equal.FloatEqual(x.PackedFloat[i], y.PackedFloat[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("packed_float", i), X: x.PackedFloat[i], Y: y.PackedFloat[i]})
+			}
+		}
+	}
+	if len(x.PackedDouble) != len(y.PackedDouble) {
+		d = append(d, equal.Difference{Path: "packed_double", X: x.PackedDouble, Y: y.PackedDouble})
+	} else {
+		for i := 0; i < len(x.PackedDouble); i++ {
+			if !equal.FloatEqual(x.PackedDouble[i], y.PackedDouble[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("packed_double", i), X: x.PackedDouble[i], Y: y.PackedDouble[i]})
+			}
+		}
+	}
+	if len(x.PackedBool) != len(y.PackedBool) {
+		d = append(d, equal.Difference{Path: "packed_bool", X: x.PackedBool, Y: y.PackedBool})
+	} else {
+		for i := 0; i < len(x.PackedBool); i++ {
+			if x.PackedBool[i] != y.PackedBool[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_bool", i), X: x.PackedBool[i], Y: y.PackedBool[i]})
+			}
+		}
+	}
+	if len(x.PackedEnum) != len(y.PackedEnum) {
+		d = append(d, equal.Difference{Path: "packed_enum", X: x.PackedEnum, Y: y.PackedEnum})
+	} else {
+		for i := 0; i < len(x.PackedEnum); i++ {
+			if x.PackedEnum[i] != y.PackedEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("packed_enum", i), X: x.PackedEnum[i], Y: y.PackedEnum[i]})
+			}
+		}
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestUnpackedTypes) Diff(y *TestUnpackedTypes) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if len(x.UnpackedInt32) != len(y.UnpackedInt32) {
+		d = append(d, equal.Difference{Path: "unpacked_int32", X: x.UnpackedInt32, Y: y.UnpackedInt32})
+	} else {
+		for i := 0; i < len(x.UnpackedInt32); i++ {
+			if x.UnpackedInt32[i] != y.UnpackedInt32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_int32", i), X: x.UnpackedInt32[i], Y: y.UnpackedInt32[i]})
+			}
+		}
+	}
+	if len(x.UnpackedInt64) != len(y.UnpackedInt64) {
+		d = append(d, equal.Difference{Path: "unpacked_int64", X: x.UnpackedInt64, Y: y.UnpackedInt64})
+	} else {
+		for i := 0; i < len(x.UnpackedInt64); i++ {
+			if x.UnpackedInt64[i] != y.UnpackedInt64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_int64", i), X: x.UnpackedInt64[i], Y: y.UnpackedInt64[i]})
+			}
+		}
+	}
+	if len(x.UnpackedUint32) != len(y.UnpackedUint32) {
+		d = append(d, equal.Difference{Path: "unpacked_uint32", X: x.UnpackedUint32, Y: y.UnpackedUint32})
+	} else {
+		for i := 0; i < len(x.UnpackedUint32); i++ {
+			if x.UnpackedUint32[i] != y.UnpackedUint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_uint32", i), X: x.UnpackedUint32[i], Y: y.UnpackedUint32[i]})
+			}
+		}
+	}
+	if len(x.UnpackedUint64) != len(y.UnpackedUint64) {
+		d = append(d, equal.Difference{Path: "unpacked_uint64", X: x.UnpackedUint64, Y: y.UnpackedUint64})
+	} else {
+		for i := 0; i < len(x.UnpackedUint64); i++ {
+			if x.UnpackedUint64[i] != y.UnpackedUint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_uint64", i), X: x.UnpackedUint64[i], Y: y.UnpackedUint64[i]})
+			}
+		}
+	}
+	if len(x.UnpackedSint32) != len(y.UnpackedSint32) {
+		d = append(d, equal.Difference{Path: "unpacked_sint32", X: x.UnpackedSint32, Y: y.UnpackedSint32})
+	} else {
+		for i := 0; i < len(x.UnpackedSint32); i++ {
+			if x.UnpackedSint32[i] != y.UnpackedSint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_sint32", i), X: x.UnpackedSint32[i], Y: y.UnpackedSint32[i]})
+			}
+		}
+	}
+	if len(x.UnpackedSint64) != len(y.UnpackedSint64) {
+		d = append(d, equal.Difference{Path: "unpacked_sint64", X: x.UnpackedSint64, Y: y.UnpackedSint64})
+	} else {
+		for i := 0; i < len(x.UnpackedSint64); i++ {
+			if x.UnpackedSint64[i] != y.UnpackedSint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_sint64", i), X: x.UnpackedSint64[i], Y: y.UnpackedSint64[i]})
+			}
+		}
+	}
+	if len(x.UnpackedFixed32) != len(y.UnpackedFixed32) {
+		d = append(d, equal.Difference{Path: "unpacked_fixed32", X: x.UnpackedFixed32, Y: y.UnpackedFixed32})
+	} else {
+		for i := 0; i < len(x.UnpackedFixed32); i++ {
+			if x.UnpackedFixed32[i] != y.UnpackedFixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_fixed32", i), X: x.UnpackedFixed32[i], Y: y.UnpackedFixed32[i]})
+			}
+		}
+	}
+	if len(x.UnpackedFixed64) != len(y.UnpackedFixed64) {
+		d = append(d, equal.Difference{Path: "unpacked_fixed64", X: x.UnpackedFixed64, Y: y.UnpackedFixed64})
+	} else {
+		for i := 0; i < len(x.UnpackedFixed64); i++ {
+			if x.UnpackedFixed64[i] != y.UnpackedFixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_fixed64", i), X: x.UnpackedFixed64[i], Y: y.UnpackedFixed64[i]})
+			}
+		}
+	}
+	if len(x.UnpackedSfixed32) != len(y.UnpackedSfixed32) {
+		d = append(d, equal.Difference{Path: "unpacked_sfixed32", X: x.UnpackedSfixed32, Y: y.UnpackedSfixed32})
+	} else {
+		for i := 0; i < len(x.UnpackedSfixed32); i++ {
+			if x.UnpackedSfixed32[i] != y.UnpackedSfixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_sfixed32", i), X: x.UnpackedSfixed32[i], Y: y.UnpackedSfixed32[i]})
+			}
+		}
+	}
+	if len(x.UnpackedSfixed64) != len(y.UnpackedSfixed64) {
+		d = append(d, equal.Difference{Path: "unpacked_sfixed64", X: x.UnpackedSfixed64, Y: y.UnpackedSfixed64})
+	} else {
+		for i := 0; i < len(x.UnpackedSfixed64); i++ {
+			if x.UnpackedSfixed64[i] != y.UnpackedSfixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_sfixed64", i), X: x.UnpackedSfixed64[i], Y: y.UnpackedSfixed64[i]})
+			}
+		}
+	}
+	if len(x.UnpackedFloat) != len(y.UnpackedFloat) {
+		d = append(d, equal.Difference{Path: "unpacked_float", X: x.UnpackedFloat, Y: y.UnpackedFloat})
+	} else {
+		for i := 0; i < len(x.UnpackedFloat); i++ {
+			if !equal.FloatEqual(x.UnpackedFloat[i], y.UnpackedFloat[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_float", i), X: x.UnpackedFloat[i], Y: y.UnpackedFloat[i]})
+			}
+		}
+	}
+	if len(x.UnpackedDouble) != len(y.UnpackedDouble) {
+		d = append(d, equal.Difference{Path: "unpacked_double", X: x.UnpackedDouble, Y: y.UnpackedDouble})
+	} else {
+		for i := 0; i < len(x.UnpackedDouble); i++ {
+			if !equal.FloatEqual(x.UnpackedDouble[i], y.UnpackedDouble[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_double", i), X: x.UnpackedDouble[i], Y: y.UnpackedDouble[i]})
+			}
+		}
+	}
+	if len(x.UnpackedBool) != len(y.UnpackedBool) {
+		d = append(d, equal.Difference{Path: "unpacked_bool", X: x.UnpackedBool, Y: y.UnpackedBool})
+	} else {
+		for i := 0; i < len(x.UnpackedBool); i++ {
+			if x.UnpackedBool[i] != y.UnpackedBool[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_bool", i), X: x.UnpackedBool[i], Y: y.UnpackedBool[i]})
+			}
+		}
+	}
+	if len(x.UnpackedEnum) != len(y.UnpackedEnum) {
+		d = append(d, equal.Difference{Path: "unpacked_enum", X: x.UnpackedEnum, Y: y.UnpackedEnum})
+	} else {
+		for i := 0; i < len(x.UnpackedEnum); i++ {
+			if x.UnpackedEnum[i] != y.UnpackedEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("unpacked_enum", i), X: x.UnpackedEnum[i], Y: y.UnpackedEnum[i]})
+			}
+		}
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestPackedExtensions) Diff(y *TestPackedExtensions) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	d = append(d, equal.DiffExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil)...)
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestUnpackedExtensions) Diff(y *TestUnpackedExtensions) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	d = append(d, equal.DiffExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil)...)
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *FooRequest) Diff(y *FooRequest) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *FooResponse) Diff(y *FooResponse) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *WeirdDefault) Diff(y *WeirdDefault) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalBytesEqual(x.WeirdDefault, y.WeirdDefault) {
+		d = append(d, equal.Difference{Path: "weird_default", X: x.WeirdDefault, Y: y.WeirdDefault})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *RemoteDefault) Diff(y *RemoteDefault) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.Default, y.Default) {
+		d = append(d, equal.Difference{Path: "default", X: x.Default, Y: y.Default})
+	}
+	if !equal.OptionalEqual(x.Zero, y.Zero) {
+		d = append(d, equal.Difference{Path: "zero", X: x.Zero, Y: y.Zero})
+	}
+	if !equal.OptionalEqual(x.One, y.One) {
+		d = append(d, equal.Difference{Path: "one", X: x.One, Y: y.One})
+	}
+	if !equal.OptionalEqual(x.Elevent, y.Elevent) {
+		d = append(d, equal.Difference{Path: "elevent", X: x.Elevent, Y: y.Elevent})
+	}
+	if !equal.OptionalEqual(x.Seventeen, y.Seventeen) {
+		d = append(d, equal.Difference{Path: "seventeen", X: x.Seventeen, Y: y.Seventeen})
+	}
+	if !equal.OptionalEqual(x.Thirtyseven, y.Thirtyseven) {
+		d = append(d, equal.Difference{Path: "thirtyseven", X: x.Thirtyseven, Y: y.Thirtyseven})
+	}
+	if !equal.OptionalEqual(x.Sixtyseven, y.Sixtyseven) {
+		d = append(d, equal.Difference{Path: "sixtyseven", X: x.Sixtyseven, Y: y.Sixtyseven})
+	}
+	if !equal.OptionalEqual(x.Negative, y.Negative) {
+		d = append(d, equal.Difference{Path: "negative", X: x.Negative, Y: y.Negative})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes_NestedMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Corecursive != nil)
+	x.Corecursive.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes_OptionalGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashBool(h, x.SameFieldNumber != nil)
+	if p := x.SameFieldNumber; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes_RepeatedGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes_OneofGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.B != nil)
+	if p := x.B; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.OptionalInt32 != nil)
+	if p := x.OptionalInt32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalInt64 != nil)
+	if p := x.OptionalInt64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalUint32 != nil)
+	if p := x.OptionalUint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalUint64 != nil)
+	if p := x.OptionalUint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSint32 != nil)
+	if p := x.OptionalSint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSint64 != nil)
+	if p := x.OptionalSint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFixed32 != nil)
+	if p := x.OptionalFixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFixed64 != nil)
+	if p := x.OptionalFixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSfixed32 != nil)
+	if p := x.OptionalSfixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSfixed64 != nil)
+	if p := x.OptionalSfixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFloat != nil)
+	if p := x.OptionalFloat; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.OptionalDouble != nil)
+	if p := x.OptionalDouble; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.OptionalBool != nil)
+	if p := x.OptionalBool; p != nil {
+		equal.HashBool(h, *p)
+	}
+	equal.HashBool(h, x.OptionalString != nil)
+	if p := x.OptionalString; p != nil {
+		equal.HashString(h, *p)
+	}
+	equal.HashBool(h, x.OptionalBytes != nil)
+	equal.HashBytes(h, x.OptionalBytes)
+	equal.HashBool(h, x.Optionalgroup != nil)
+	x.Optionalgroup.Hash(h)
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashBool(h, x.OptionalForeignMessage != nil)
+	x.OptionalForeignMessage.Hash(h)
+	equal.HashBool(h, x.OptionalImportMessage != nil)
+	x.OptionalImportMessage.Hash(h)
+	equal.HashBool(h, x.OptionalNestedEnum != nil)
+	if p := x.OptionalNestedEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalForeignEnum != nil)
+	if p := x.OptionalForeignEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalImportEnum != nil)
+	if p := x.OptionalImportEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedInt32)))
+	for i := 0; i < len(x.RepeatedInt32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedInt32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedInt64)))
+	for i := 0; i < len(x.RepeatedInt64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedInt64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedUint32)))
+	for i := 0; i < len(x.RepeatedUint32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedUint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedUint64)))
+	for i := 0; i < len(x.RepeatedUint64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedUint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSint32)))
+	for i := 0; i < len(x.RepeatedSint32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSint64)))
+	for i := 0; i < len(x.RepeatedSint64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFixed32)))
+	for i := 0; i < len(x.RepeatedFixed32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedFixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFixed64)))
+	for i := 0; i < len(x.RepeatedFixed64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedFixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSfixed32)))
+	for i := 0; i < len(x.RepeatedSfixed32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSfixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSfixed64)))
+	for i := 0; i < len(x.RepeatedSfixed64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSfixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFloat)))
+	for i := 0; i < len(x.RepeatedFloat); i++ {
+		equal.HashFloat64(h, float64(x.RepeatedFloat[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedDouble)))
+	for i := 0; i < len(x.RepeatedDouble); i++ {
+		equal.HashFloat64(h, float64(x.RepeatedDouble[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedBool)))
+	for i := 0; i < len(x.RepeatedBool); i++ {
+		equal.HashBool(h, x.RepeatedBool[i])
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedString)))
+	for i := 0; i < len(x.RepeatedString); i++ {
+		equal.HashString(h, x.RepeatedString[i])
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedBytes)))
+	for i := 0; i < len(x.RepeatedBytes); i++ {
+		equal.HashBytes(h, x.RepeatedBytes[i])
+	}
+	equal.HashUint64(h, uint64(len(x.Repeatedgroup)))
+	for i := 0; i < len(x.Repeatedgroup); i++ {
+		equal.HashBool(h, x.Repeatedgroup[i] != nil)
+		x.Repeatedgroup[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedNestedMessage)))
+	for i := 0; i < len(x.RepeatedNestedMessage); i++ {
+		equal.HashBool(h, x.RepeatedNestedMessage[i] != nil)
+		x.RepeatedNestedMessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedForeignMessage)))
+	for i := 0; i < len(x.RepeatedForeignMessage); i++ {
+		equal.HashBool(h, x.RepeatedForeignMessage[i] != nil)
+		x.RepeatedForeignMessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedImportmessage)))
+	for i := 0; i < len(x.RepeatedImportmessage); i++ {
+		equal.HashBool(h, x.RepeatedImportmessage[i] != nil)
+		x.RepeatedImportmessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedNestedEnum)))
+	for i := 0; i < len(x.RepeatedNestedEnum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedNestedEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedForeignEnum)))
+	for i := 0; i < len(x.RepeatedForeignEnum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedForeignEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedImportenum)))
+	for i := 0; i < len(x.RepeatedImportenum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedImportenum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Int32)))
+	if len(x.MapInt32Int32) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Int32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt64Int64)))
+	if len(x.MapInt64Int64) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt64Int64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapUint32Uint32)))
+	if len(x.MapUint32Uint32) > 0 {
+		var sum uint64
+		for k, v := range x.MapUint32Uint32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapUint64Uint64)))
+	if len(x.MapUint64Uint64) > 0 {
+		var sum uint64
+		for k, v := range x.MapUint64Uint64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSint32Sint32)))
+	if len(x.MapSint32Sint32) > 0 {
+		var sum uint64
+		for k, v := range x.MapSint32Sint32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSint64Sint64)))
+	if len(x.MapSint64Sint64) > 0 {
+		var sum uint64
+		for k, v := range x.MapSint64Sint64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapFixed32Fixed32)))
+	if len(x.MapFixed32Fixed32) > 0 {
+		var sum uint64
+		for k, v := range x.MapFixed32Fixed32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapFixed64Fixed64)))
+	if len(x.MapFixed64Fixed64) > 0 {
+		var sum uint64
+		for k, v := range x.MapFixed64Fixed64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSfixed32Sfixed32)))
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		var sum uint64
+		for k, v := range x.MapSfixed32Sfixed32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSfixed64Sfixed64)))
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		var sum uint64
+		for k, v := range x.MapSfixed64Sfixed64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Float)))
+	if len(x.MapInt32Float) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Float {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashFloat64(&e, float64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Double)))
+	if len(x.MapInt32Double) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Double {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashFloat64(&e, float64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapBoolBool)))
+	if len(x.MapBoolBool) > 0 {
+		var sum uint64
+		for k, v := range x.MapBoolBool {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashBool(&e, k)
+			equal.HashBool(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringString)))
+	if len(x.MapStringString) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringString {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashString(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringBytes)))
+	if len(x.MapStringBytes) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringBytes {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashBytes(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringNestedMessage)))
+	if len(x.MapStringNestedMessage) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringNestedMessage {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashBool(&e, v != nil)
+			v.Hash(&e)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringNestedEnum)))
+	if len(x.MapStringNestedEnum) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringNestedEnum {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashBool(h, x.DefaultInt32 != nil)
+	if p := x.DefaultInt32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultInt64 != nil)
+	if p := x.DefaultInt64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultUint32 != nil)
+	if p := x.DefaultUint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultUint64 != nil)
+	if p := x.DefaultUint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultSint32 != nil)
+	if p := x.DefaultSint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultSint64 != nil)
+	if p := x.DefaultSint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultFixed32 != nil)
+	if p := x.DefaultFixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultFixed64 != nil)
+	if p := x.DefaultFixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultSfixed32 != nil)
+	if p := x.DefaultSfixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultSfixed64 != nil)
+	if p := x.DefaultSfixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultFloat != nil)
+	if p := x.DefaultFloat; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.DefaultDouble != nil)
+	if p := x.DefaultDouble; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.DefaultBool != nil)
+	if p := x.DefaultBool; p != nil {
+		equal.HashBool(h, *p)
+	}
+	equal.HashBool(h, x.DefaultString != nil)
+	if p := x.DefaultString; p != nil {
+		equal.HashString(h, *p)
+	}
+	equal.HashBool(h, x.DefaultBytes != nil)
+	equal.HashBytes(h, x.DefaultBytes)
+	equal.HashBool(h, x.DefaultNestedEnum != nil)
+	if p := x.DefaultNestedEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.DefaultForeignEnum != nil)
+	if p := x.DefaultForeignEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		equal.HashUint64(h, 0)
+	case *TestAllTypes_OneofUint32:
+		equal.HashUint64(h, 111)
+		equal.HashUint64(h, uint64(v.OneofUint32))
+	case *TestAllTypes_OneofNestedMessage:
+		equal.HashUint64(h, 112)
+		equal.HashBool(h, v.OneofNestedMessage != nil)
+		v.OneofNestedMessage.Hash(h)
+	case *TestAllTypes_OneofString:
+		equal.HashUint64(h, 113)
+		equal.HashString(h, v.OneofString)
+	case *TestAllTypes_OneofBytes:
+		equal.HashUint64(h, 114)
+		equal.HashBytes(h, v.OneofBytes)
+	case *TestAllTypes_OneofBool:
+		equal.HashUint64(h, 115)
+		equal.HashBool(h, v.OneofBool)
+	case *TestAllTypes_OneofUint64:
+		equal.HashUint64(h, 116)
+		equal.HashUint64(h, uint64(v.OneofUint64))
+	case *TestAllTypes_OneofFloat:
+		equal.HashUint64(h, 117)
+		equal.HashFloat64(h, float64(v.OneofFloat))
+	case *TestAllTypes_OneofDouble:
+		equal.HashUint64(h, 118)
+		equal.HashFloat64(h, float64(v.OneofDouble))
+	case *TestAllTypes_OneofEnum:
+		equal.HashUint64(h, 119)
+		equal.HashUint64(h, uint64(v.OneofEnum))
+	case *TestAllTypes_Oneofgroup:
+		equal.HashUint64(h, 121)
+		equal.HashBool(h, v.Oneofgroup != nil)
+		v.Oneofgroup.Hash(h)
+	case *TestAllTypes_OneofWrappersStringValue:
+		equal.HashUint64(h, 122)
+		equal.HashBool(h, v.OneofWrappersStringValue != nil)
+		if p := v.OneofWrappersStringValue; p != nil {
+			equal.HashString(h, p.Value)
+		}
+	}
+	switch v := x.OneofOptional.(type) {
+	case nil:
+		equal.HashUint64(h, 0)
+	case *TestAllTypes_OneofOptionalUint32:
+		equal.HashUint64(h, 120)
+		equal.HashUint64(h, uint64(v.OneofOptionalUint32))
+	}
+	equal.HashBool(h, x.Any != nil)
+	if p := x.Any; p != nil {
+		equal.HashString(h, p.TypeUrl)
+		equal.HashBytes(h, p.Value)
+	}
+	equal.HashBool(h, x.Duration != nil)
+	if p := x.Duration; p != nil {
+		equal.HashUint64(h, uint64(p.Seconds))
+		equal.HashUint64(h, uint64(p.Nanos))
+	}
+	equal.HashBool(h, x.Empty != nil)
+	equal.HashBool(h, x.Timestamp != nil)
+	if p := x.Timestamp; p != nil {
+		equal.HashUint64(h, uint64(p.Seconds))
+		equal.HashUint64(h, uint64(p.Nanos))
+	}
+	equal.HashBool(h, x.WrappersBoolValue != nil)
+	if p := x.WrappersBoolValue; p != nil {
+		equal.HashBool(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersBytesValue != nil)
+	if p := x.WrappersBytesValue; p != nil {
+		equal.HashBytes(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersDoubleValue != nil)
+	if p := x.WrappersDoubleValue; p != nil {
+		equal.HashFloat64(h, float64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersFloatValue != nil)
+	if p := x.WrappersFloatValue; p != nil {
+		equal.HashFloat64(h, float64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersInt32Value != nil)
+	if p := x.WrappersInt32Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersInt64Value != nil)
+	if p := x.WrappersInt64Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersStringValue != nil)
+	if p := x.WrappersStringValue; p != nil {
+		equal.HashString(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersUint32Value != nil)
+	if p := x.WrappersUint32Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersUint64Value != nil)
+	if p := x.WrappersUint64Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestDeprecatedMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.DeprecatedInt32 != nil)
+	if p := x.DeprecatedInt32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	switch v := x.DeprecatedOneof.(type) {
+	case nil:
+		equal.HashUint64(h, 0)
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		equal.HashUint64(h, 2)
+		equal.HashUint64(h, uint64(v.DeprecatedOneofField))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *ForeignMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.C != nil)
+	if p := x.C; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.D != nil)
+	if p := x.D; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestReservedFields) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllExtensions_NestedMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Corecursive != nil)
+	x.Corecursive.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllExtensions) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashExtensions(h, x.ProtoReflect(), equal.FloatNumeric)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *OptionalGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.SameFieldNumber != nil)
+	if p := x.SameFieldNumber; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *RepeatedGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestNestedExtension) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestRequired) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.RequiredField != nil)
+	if p := x.RequiredField; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestRequiredForeign) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.OptionalMessage != nil)
+	x.OptionalMessage.Hash(h)
+	equal.HashUint64(h, uint64(len(x.RepeatedMessage)))
+	for i := 0; i < len(x.RepeatedMessage); i++ {
+		equal.HashBool(h, x.RepeatedMessage[i] != nil)
+		x.RepeatedMessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.MapMessage)))
+	if len(x.MapMessage) > 0 {
+		var sum uint64
+		for k, v := range x.MapMessage {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashBool(&e, v != nil)
+			v.Hash(&e)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		equal.HashUint64(h, 0)
+	case *TestRequiredForeign_OneofMessage:
+		equal.HashUint64(h, 4)
+		equal.HashBool(h, v.OneofMessage != nil)
+		v.OneofMessage.Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestRequiredGroupFields) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.Optionalgroup != nil)
+	x.Optionalgroup.Hash(h)
+	equal.HashUint64(h, uint64(len(x.Repeatedgroup)))
+	for i := 0; i < len(x.Repeatedgroup); i++ {
+		equal.HashBool(h, x.Repeatedgroup[i] != nil)
+		x.Repeatedgroup[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestWeak) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestPackedTypes) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.PackedInt32)))
+	for i := 0; i < len(x.PackedInt32); i++ {
+		equal.HashUint64(h, uint64(x.PackedInt32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedInt64)))
+	for i := 0; i < len(x.PackedInt64); i++ {
+		equal.HashUint64(h, uint64(x.PackedInt64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedUint32)))
+	for i := 0; i < len(x.PackedUint32); i++ {
+		equal.HashUint64(h, uint64(x.PackedUint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedUint64)))
+	for i := 0; i < len(x.PackedUint64); i++ {
+		equal.HashUint64(h, uint64(x.PackedUint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedSint32)))
+	for i := 0; i < len(x.PackedSint32); i++ {
+		equal.HashUint64(h, uint64(x.PackedSint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedSint64)))
+	for i := 0; i < len(x.PackedSint64); i++ {
+		equal.HashUint64(h, uint64(x.PackedSint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedFixed32)))
+	for i := 0; i < len(x.PackedFixed32); i++ {
+		equal.HashUint64(h, uint64(x.PackedFixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedFixed64)))
+	for i := 0; i < len(x.PackedFixed64); i++ {
+		equal.HashUint64(h, uint64(x.PackedFixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedSfixed32)))
+	for i := 0; i < len(x.PackedSfixed32); i++ {
+		equal.HashUint64(h, uint64(x.PackedSfixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedSfixed64)))
+	for i := 0; i < len(x.PackedSfixed64); i++ {
+		equal.HashUint64(h, uint64(x.PackedSfixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedFloat)))
+	for i := 0; i < len(x.PackedFloat); i++ {
+		equal.HashFloat64(h, float64(x.PackedFloat[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedDouble)))
+	for i := 0; i < len(x.PackedDouble); i++ {
+		equal.HashFloat64(h, float64(x.PackedDouble[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.PackedBool)))
+	for i := 0; i < len(x.PackedBool); i++ {
+		equal.HashBool(h, x.PackedBool[i])
+	}
+	equal.HashUint64(h, uint64(len(x.PackedEnum)))
+	for i := 0; i < len(x.PackedEnum); i++ {
+		equal.HashUint64(h, uint64(x.PackedEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestUnpackedTypes) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedInt32)))
+	for i := 0; i < len(x.UnpackedInt32); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedInt32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedInt64)))
+	for i := 0; i < len(x.UnpackedInt64); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedInt64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedUint32)))
+	for i := 0; i < len(x.UnpackedUint32); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedUint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedUint64)))
+	for i := 0; i < len(x.UnpackedUint64); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedUint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedSint32)))
+	for i := 0; i < len(x.UnpackedSint32); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedSint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedSint64)))
+	for i := 0; i < len(x.UnpackedSint64); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedSint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedFixed32)))
+	for i := 0; i < len(x.UnpackedFixed32); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedFixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedFixed64)))
+	for i := 0; i < len(x.UnpackedFixed64); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedFixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedSfixed32)))
+	for i := 0; i < len(x.UnpackedSfixed32); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedSfixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedSfixed64)))
+	for i := 0; i < len(x.UnpackedSfixed64); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedSfixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedFloat)))
+	for i := 0; i < len(x.UnpackedFloat); i++ {
+		equal.HashFloat64(h, float64(x.UnpackedFloat[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedDouble)))
+	for i := 0; i < len(x.UnpackedDouble); i++ {
+		equal.HashFloat64(h, float64(x.UnpackedDouble[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedBool)))
+	for i := 0; i < len(x.UnpackedBool); i++ {
+		equal.HashBool(h, x.UnpackedBool[i])
+	}
+	equal.HashUint64(h, uint64(len(x.UnpackedEnum)))
+	for i := 0; i < len(x.UnpackedEnum); i++ {
+		equal.HashUint64(h, uint64(x.UnpackedEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestPackedExtensions) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashExtensions(h, x.ProtoReflect(), equal.FloatNumeric)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestUnpackedExtensions) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashExtensions(h, x.ProtoReflect(), equal.FloatNumeric)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *FooRequest) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *FooResponse) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *WeirdDefault) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.WeirdDefault != nil)
+	equal.HashBytes(h, x.WeirdDefault)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *RemoteDefault) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.Default != nil)
+	if p := x.Default; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Zero != nil)
+	if p := x.Zero; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.One != nil)
+	if p := x.One; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Elevent != nil)
+	if p := x.Elevent; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Seventeen != nil)
+	if p := x.Seventeen; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Thirtyseven != nil)
+	if p := x.Thirtyseven; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Sixtyseven != nil)
+	if p := x.Sixtyseven; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Negative != nil)
+	if p := x.Negative; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes_NestedMessage) Compare(y *TestAllTypes_NestedMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.Corecursive.Compare(y.Corecursive); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes_OptionalGroup) Compare(y *TestAllTypes_OptionalGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.SameFieldNumber != nil, y.SameFieldNumber != nil); c != 0 {
+		return c
+	}
+	if p, q := x.SameFieldNumber, y.SameFieldNumber; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes_RepeatedGroup) Compare(y *TestAllTypes_RepeatedGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes_OneofGroup) Compare(y *TestAllTypes_OneofGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.B != nil, y.B != nil); c != 0 {
+		return c
+	}
+	if p, q := x.B, y.B; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes) Compare(y *TestAllTypes) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.OptionalInt32 != nil, y.OptionalInt32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalInt32, y.OptionalInt32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalInt64 != nil, y.OptionalInt64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalInt64, y.OptionalInt64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalUint32 != nil, y.OptionalUint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalUint32, y.OptionalUint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalUint64 != nil, y.OptionalUint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalUint64, y.OptionalUint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSint32 != nil, y.OptionalSint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSint32, y.OptionalSint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSint64 != nil, y.OptionalSint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSint64, y.OptionalSint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFixed32 != nil, y.OptionalFixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFixed32, y.OptionalFixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFixed64 != nil, y.OptionalFixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFixed64, y.OptionalFixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSfixed32 != nil, y.OptionalSfixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSfixed32, y.OptionalSfixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSfixed64 != nil, y.OptionalSfixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSfixed64, y.OptionalSfixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFloat != nil, y.OptionalFloat != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFloat, y.OptionalFloat; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalDouble != nil, y.OptionalDouble != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalDouble, y.OptionalDouble; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalBool != nil, y.OptionalBool != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalBool, y.OptionalBool; p != nil {
+		if c := equal.CompareBool(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalString != nil, y.OptionalString != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalString, y.OptionalString; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalBytes != nil, y.OptionalBytes != nil); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(x.OptionalBytes, y.OptionalBytes); c != 0 {
+		return c
+	}
+	if c := x.Optionalgroup.Compare(y.Optionalgroup); c != 0 {
+		return c
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := x.OptionalForeignMessage.Compare(y.OptionalForeignMessage); c != 0 {
+		return c
+	}
+	if c := x.OptionalImportMessage.Compare(y.OptionalImportMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.OptionalNestedEnum != nil, y.OptionalNestedEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalNestedEnum, y.OptionalNestedEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalForeignEnum != nil, y.OptionalForeignEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalForeignEnum, y.OptionalForeignEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalImportEnum != nil, y.OptionalImportEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalImportEnum, y.OptionalImportEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	for i := 0; i < len(x.RepeatedInt32) && i < len(y.RepeatedInt32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedInt32[i], y.RepeatedInt32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedInt32), len(y.RepeatedInt32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedInt64) && i < len(y.RepeatedInt64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedInt64[i], y.RepeatedInt64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedInt64), len(y.RepeatedInt64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedUint32) && i < len(y.RepeatedUint32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedUint32[i], y.RepeatedUint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedUint32), len(y.RepeatedUint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedUint64) && i < len(y.RepeatedUint64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedUint64[i], y.RepeatedUint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedUint64), len(y.RepeatedUint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSint32) && i < len(y.RepeatedSint32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSint32[i], y.RepeatedSint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSint32), len(y.RepeatedSint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSint64) && i < len(y.RepeatedSint64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSint64[i], y.RepeatedSint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSint64), len(y.RepeatedSint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFixed32) && i < len(y.RepeatedFixed32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedFixed32[i], y.RepeatedFixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFixed32), len(y.RepeatedFixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFixed64) && i < len(y.RepeatedFixed64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedFixed64[i], y.RepeatedFixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFixed64), len(y.RepeatedFixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSfixed32) && i < len(y.RepeatedSfixed32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSfixed32[i], y.RepeatedSfixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSfixed32), len(y.RepeatedSfixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSfixed64) && i < len(y.RepeatedSfixed64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSfixed64[i], y.RepeatedSfixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSfixed64), len(y.RepeatedSfixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFloat) && i < len(y.RepeatedFloat); i++ {
+		if c := equal.CompareFloat64(float64(x.RepeatedFloat[i]), float64(y.RepeatedFloat[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFloat), len(y.RepeatedFloat)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedDouble) && i < len(y.RepeatedDouble); i++ {
+		if c := equal.CompareFloat64(float64(x.RepeatedDouble[i]), float64(y.RepeatedDouble[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedDouble), len(y.RepeatedDouble)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedBool) && i < len(y.RepeatedBool); i++ {
+		if c := equal.CompareBool(x.RepeatedBool[i], y.RepeatedBool[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedBool), len(y.RepeatedBool)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedString) && i < len(y.RepeatedString); i++ {
+		if c := equal.CompareOrdered(x.RepeatedString[i], y.RepeatedString[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedString), len(y.RepeatedString)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedBytes) && i < len(y.RepeatedBytes); i++ {
+		if c := bytes.Compare(x.RepeatedBytes[i], y.RepeatedBytes[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedBytes), len(y.RepeatedBytes)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.Repeatedgroup) && i < len(y.Repeatedgroup); i++ {
+		if c := x.Repeatedgroup[i].Compare(y.Repeatedgroup[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.Repeatedgroup), len(y.Repeatedgroup)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedNestedMessage) && i < len(y.RepeatedNestedMessage); i++ {
+		if c := x.RepeatedNestedMessage[i].Compare(y.RepeatedNestedMessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedNestedMessage), len(y.RepeatedNestedMessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedForeignMessage) && i < len(y.RepeatedForeignMessage); i++ {
+		if c := x.RepeatedForeignMessage[i].Compare(y.RepeatedForeignMessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedForeignMessage), len(y.RepeatedForeignMessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedImportmessage) && i < len(y.RepeatedImportmessage); i++ {
+		if c := x.RepeatedImportmessage[i].Compare(y.RepeatedImportmessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedImportmessage), len(y.RepeatedImportmessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedNestedEnum) && i < len(y.RepeatedNestedEnum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedNestedEnum[i], y.RepeatedNestedEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedNestedEnum), len(y.RepeatedNestedEnum)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedForeignEnum) && i < len(y.RepeatedForeignEnum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedForeignEnum[i], y.RepeatedForeignEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedForeignEnum), len(y.RepeatedForeignEnum)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedImportenum) && i < len(y.RepeatedImportenum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedImportenum[i], y.RepeatedImportenum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedImportenum), len(y.RepeatedImportenum)); c != 0 {
+		return c
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Int32, y.MapInt32Int32) {
+		xv, xok := x.MapInt32Int32[k]
+		yv, yok := y.MapInt32Int32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt64Int64, y.MapInt64Int64) {
+		xv, xok := x.MapInt64Int64[k]
+		yv, yok := y.MapInt64Int64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapUint32Uint32, y.MapUint32Uint32) {
+		xv, xok := x.MapUint32Uint32[k]
+		yv, yok := y.MapUint32Uint32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapUint64Uint64, y.MapUint64Uint64) {
+		xv, xok := x.MapUint64Uint64[k]
+		yv, yok := y.MapUint64Uint64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSint32Sint32, y.MapSint32Sint32) {
+		xv, xok := x.MapSint32Sint32[k]
+		yv, yok := y.MapSint32Sint32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSint64Sint64, y.MapSint64Sint64) {
+		xv, xok := x.MapSint64Sint64[k]
+		yv, yok := y.MapSint64Sint64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
+		xv, xok := x.MapFixed32Fixed32[k]
+		yv, yok := y.MapFixed32Fixed32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		xv, xok := x.MapFixed64Fixed64[k]
+		yv, yok := y.MapFixed64Fixed64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
+		xv, xok := x.MapSfixed32Sfixed32[k]
+		yv, yok := y.MapSfixed32Sfixed32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		xv, xok := x.MapSfixed64Sfixed64[k]
+		yv, yok := y.MapSfixed64Sfixed64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Float, y.MapInt32Float) {
+		xv, xok := x.MapInt32Float[k]
+		yv, yok := y.MapInt32Float[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareFloat64(float64(xv), float64(yv)); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Double, y.MapInt32Double) {
+		xv, xok := x.MapInt32Double[k]
+		yv, yok := y.MapInt32Double[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareFloat64(float64(xv), float64(yv)); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedBoolKeys(x.MapBoolBool, y.MapBoolBool) {
+		xv, xok := x.MapBoolBool[k]
+		yv, yok := y.MapBoolBool[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareBool(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringString, y.MapStringString) {
+		xv, xok := x.MapStringString[k]
+		yv, yok := y.MapStringString[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringBytes, y.MapStringBytes) {
+		xv, xok := x.MapStringBytes[k]
+		yv, yok := y.MapStringBytes[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := bytes.Compare(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringNestedMessage, y.MapStringNestedMessage) {
+		xv, xok := x.MapStringNestedMessage[k]
+		yv, yok := y.MapStringNestedMessage[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := xv.Compare(yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringNestedEnum, y.MapStringNestedEnum) {
+		xv, xok := x.MapStringNestedEnum[k]
+		yv, yok := y.MapStringNestedEnum[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultInt32 != nil, y.DefaultInt32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultInt32, y.DefaultInt32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultInt64 != nil, y.DefaultInt64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultInt64, y.DefaultInt64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultUint32 != nil, y.DefaultUint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultUint32, y.DefaultUint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultUint64 != nil, y.DefaultUint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultUint64, y.DefaultUint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultSint32 != nil, y.DefaultSint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultSint32, y.DefaultSint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultSint64 != nil, y.DefaultSint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultSint64, y.DefaultSint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultFixed32 != nil, y.DefaultFixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultFixed32, y.DefaultFixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultFixed64 != nil, y.DefaultFixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultFixed64, y.DefaultFixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultSfixed32 != nil, y.DefaultSfixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultSfixed32, y.DefaultSfixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultSfixed64 != nil, y.DefaultSfixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultSfixed64, y.DefaultSfixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultFloat != nil, y.DefaultFloat != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultFloat, y.DefaultFloat; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultDouble != nil, y.DefaultDouble != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultDouble, y.DefaultDouble; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultBool != nil, y.DefaultBool != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultBool, y.DefaultBool; p != nil {
+		if c := equal.CompareBool(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultString != nil, y.DefaultString != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultString, y.DefaultString; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultBytes != nil, y.DefaultBytes != nil); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(x.DefaultBytes, y.DefaultBytes); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.DefaultNestedEnum != nil, y.DefaultNestedEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultNestedEnum, y.DefaultNestedEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.DefaultForeignEnum != nil, y.DefaultForeignEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DefaultForeignEnum, y.DefaultForeignEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return -1
+		}
+	case *TestAllTypes_OneofUint32:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			if c := equal.CompareOrdered(xv.OneofUint32, yv.OneofUint32); c != 0 {
+				return c
+			}
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofNestedMessage:
+			if c := xv.OneofNestedMessage.Compare(yv.OneofNestedMessage); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofString:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofString:
+			if c := equal.CompareOrdered(xv.OneofString, yv.OneofString); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofBytes:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofBytes:
+			if c := bytes.Compare(xv.OneofBytes, yv.OneofBytes); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofBool:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofBool:
+			if c := equal.CompareBool(xv.OneofBool, yv.OneofBool); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofUint64:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofUint64:
+			if c := equal.CompareOrdered(xv.OneofUint64, yv.OneofUint64); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofFloat:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofFloat:
+			if c := equal.CompareFloat64(float64(xv.OneofFloat), float64(yv.OneofFloat)); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofDouble:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofDouble:
+			if c := equal.CompareFloat64(float64(xv.OneofDouble), float64(yv.OneofDouble)); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofEnum:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofEnum:
+			if c := equal.CompareOrdered(xv.OneofEnum, yv.OneofEnum); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case *TestAllTypes_OneofDouble:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_Oneofgroup:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_Oneofgroup:
+			if c := xv.Oneofgroup.Compare(yv.Oneofgroup); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case *TestAllTypes_OneofDouble:
+			return 1
+		case *TestAllTypes_OneofEnum:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofWrappersStringValue:
+			if c := equal.CompareBool(xv.OneofWrappersStringValue != nil, yv.OneofWrappersStringValue != nil); c != 0 {
+				return c
+			}
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; p != nil {
+				if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+					return c
+				}
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case *TestAllTypes_OneofDouble:
+			return 1
+		case *TestAllTypes_OneofEnum:
+			return 1
+		case *TestAllTypes_Oneofgroup:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	switch xv := x.OneofOptional.(type) {
+	case nil:
+		if y.OneofOptional != nil {
+			return -1
+		}
+	case *TestAllTypes_OneofOptionalUint32:
+		switch yv := y.OneofOptional.(type) {
+		case *TestAllTypes_OneofOptionalUint32:
+			if c := equal.CompareOrdered(xv.OneofOptionalUint32, yv.OneofOptionalUint32); c != 0 {
+				return c
+			}
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	if c := equal.CompareBool(x.Any != nil, y.Any != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Any, y.Any; p != nil {
+		if c := equal.CompareOrdered(p.TypeUrl, q.TypeUrl); c != 0 {
+			return c
+		}
+		if c := bytes.Compare(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Duration != nil, y.Duration != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Duration, y.Duration; p != nil {
+		if c := equal.CompareOrdered(p.Seconds, q.Seconds); c != 0 {
+			return c
+		}
+		if c := equal.CompareOrdered(p.Nanos, q.Nanos); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Empty != nil, y.Empty != nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.Timestamp != nil, y.Timestamp != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Timestamp, y.Timestamp; p != nil {
+		if c := equal.CompareOrdered(p.Seconds, q.Seconds); c != 0 {
+			return c
+		}
+		if c := equal.CompareOrdered(p.Nanos, q.Nanos); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersBoolValue != nil, y.WrappersBoolValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; p != nil {
+		if c := equal.CompareBool(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersBytesValue != nil, y.WrappersBytesValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; p != nil {
+		if c := bytes.Compare(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersDoubleValue != nil, y.WrappersDoubleValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; p != nil {
+		if c := equal.CompareFloat64(float64(p.Value), float64(q.Value)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersFloatValue != nil, y.WrappersFloatValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; p != nil {
+		if c := equal.CompareFloat64(float64(p.Value), float64(q.Value)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersInt32Value != nil, y.WrappersInt32Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersInt64Value != nil, y.WrappersInt64Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersStringValue != nil, y.WrappersStringValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersUint32Value != nil, y.WrappersUint32Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersUint64Value != nil, y.WrappersUint64Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestDeprecatedMessage) Compare(y *TestDeprecatedMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.DeprecatedInt32 != nil, y.DeprecatedInt32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.DeprecatedInt32, y.DeprecatedInt32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	switch xv := x.DeprecatedOneof.(type) {
+	case nil:
+		if y.DeprecatedOneof != nil {
+			return -1
+		}
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		switch yv := y.DeprecatedOneof.(type) {
+		case *TestDeprecatedMessage_DeprecatedOneofField:
+			if c := equal.CompareOrdered(xv.DeprecatedOneofField, yv.DeprecatedOneofField); c != 0 {
+				return c
+			}
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *ForeignMessage) Compare(y *ForeignMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.C != nil, y.C != nil); c != 0 {
+		return c
+	}
+	if p, q := x.C, y.C; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.D != nil, y.D != nil); c != 0 {
+		return c
+	}
+	if p, q := x.D, y.D; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestReservedFields) Compare(y *TestReservedFields) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllExtensions_NestedMessage) Compare(y *TestAllExtensions_NestedMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.Corecursive.Compare(y.Corecursive); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllExtensions) Compare(y *TestAllExtensions) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, func(p, q protoreflect.Message) int {
+		switch v := p.Interface().(type) {
+		case *OptionalGroup:
+			return v.Compare(q.Interface().(*OptionalGroup))
+		case *TestAllExtensions_NestedMessage:
+			return v.Compare(q.Interface().(*TestAllExtensions_NestedMessage))
+		case *RepeatedGroup:
+			return v.Compare(q.Interface().(*RepeatedGroup))
+		case *TestRequired:
+			return v.Compare(q.Interface().(*TestRequired))
+		}
+		return equal.CompareMessages(p.Interface(), q.Interface())
+	}); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *OptionalGroup) Compare(y *OptionalGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.SameFieldNumber != nil, y.SameFieldNumber != nil); c != 0 {
+		return c
+	}
+	if p, q := x.SameFieldNumber, y.SameFieldNumber; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *RepeatedGroup) Compare(y *RepeatedGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestNestedExtension) Compare(y *TestNestedExtension) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestRequired) Compare(y *TestRequired) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.RequiredField != nil, y.RequiredField != nil); c != 0 {
+		return c
+	}
+	if p, q := x.RequiredField, y.RequiredField; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestRequiredForeign) Compare(y *TestRequiredForeign) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := x.OptionalMessage.Compare(y.OptionalMessage); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedMessage) && i < len(y.RepeatedMessage); i++ {
+		if c := x.RepeatedMessage[i].Compare(y.RepeatedMessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedMessage), len(y.RepeatedMessage)); c != 0 {
+		return c
+	}
+	for _, k := range equal.SortedKeys(x.MapMessage, y.MapMessage) {
+		xv, xok := x.MapMessage[k]
+		yv, yok := y.MapMessage[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := xv.Compare(yv); c != 0 {
+			return c
+		}
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return -1
+		}
+	case *TestRequiredForeign_OneofMessage:
+		switch yv := y.OneofField.(type) {
+		case *TestRequiredForeign_OneofMessage:
+			if c := xv.OneofMessage.Compare(yv.OneofMessage); c != 0 {
+				return c
+			}
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) Compare(y *TestRequiredGroupFields_OptionalGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) Compare(y *TestRequiredGroupFields_RepeatedGroup) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestRequiredGroupFields) Compare(y *TestRequiredGroupFields) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := x.Optionalgroup.Compare(y.Optionalgroup); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.Repeatedgroup) && i < len(y.Repeatedgroup); i++ {
+		if c := x.Repeatedgroup[i].Compare(y.Repeatedgroup[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.Repeatedgroup), len(y.Repeatedgroup)); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestWeak) Compare(y *TestWeak) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestPackedTypes) Compare(y *TestPackedTypes) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	for i := 0; i < len(x.PackedInt32) && i < len(y.PackedInt32); i++ {
+		if c := equal.CompareOrdered(x.PackedInt32[i], y.PackedInt32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedInt32), len(y.PackedInt32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedInt64) && i < len(y.PackedInt64); i++ {
+		if c := equal.CompareOrdered(x.PackedInt64[i], y.PackedInt64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedInt64), len(y.PackedInt64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedUint32) && i < len(y.PackedUint32); i++ {
+		if c := equal.CompareOrdered(x.PackedUint32[i], y.PackedUint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedUint32), len(y.PackedUint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedUint64) && i < len(y.PackedUint64); i++ {
+		if c := equal.CompareOrdered(x.PackedUint64[i], y.PackedUint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedUint64), len(y.PackedUint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedSint32) && i < len(y.PackedSint32); i++ {
+		if c := equal.CompareOrdered(x.PackedSint32[i], y.PackedSint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedSint32), len(y.PackedSint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedSint64) && i < len(y.PackedSint64); i++ {
+		if c := equal.CompareOrdered(x.PackedSint64[i], y.PackedSint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedSint64), len(y.PackedSint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedFixed32) && i < len(y.PackedFixed32); i++ {
+		if c := equal.CompareOrdered(x.PackedFixed32[i], y.PackedFixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedFixed32), len(y.PackedFixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedFixed64) && i < len(y.PackedFixed64); i++ {
+		if c := equal.CompareOrdered(x.PackedFixed64[i], y.PackedFixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedFixed64), len(y.PackedFixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedSfixed32) && i < len(y.PackedSfixed32); i++ {
+		if c := equal.CompareOrdered(x.PackedSfixed32[i], y.PackedSfixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedSfixed32), len(y.PackedSfixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedSfixed64) && i < len(y.PackedSfixed64); i++ {
+		if c := equal.CompareOrdered(x.PackedSfixed64[i], y.PackedSfixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedSfixed64), len(y.PackedSfixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedFloat) && i < len(y.PackedFloat); i++ {
+		if c := equal.CompareFloat64(float64(x.PackedFloat[i]), float64(y.PackedFloat[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedFloat), len(y.PackedFloat)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedDouble) && i < len(y.PackedDouble); i++ {
+		if c := equal.CompareFloat64(float64(x.PackedDouble[i]), float64(y.PackedDouble[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedDouble), len(y.PackedDouble)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedBool) && i < len(y.PackedBool); i++ {
+		if c := equal.CompareBool(x.PackedBool[i], y.PackedBool[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedBool), len(y.PackedBool)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.PackedEnum) && i < len(y.PackedEnum); i++ {
+		if c := equal.CompareOrdered(x.PackedEnum[i], y.PackedEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.PackedEnum), len(y.PackedEnum)); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestUnpackedTypes) Compare(y *TestUnpackedTypes) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	for i := 0; i < len(x.UnpackedInt32) && i < len(y.UnpackedInt32); i++ {
+		if c := equal.CompareOrdered(x.UnpackedInt32[i], y.UnpackedInt32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedInt32), len(y.UnpackedInt32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedInt64) && i < len(y.UnpackedInt64); i++ {
+		if c := equal.CompareOrdered(x.UnpackedInt64[i], y.UnpackedInt64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedInt64), len(y.UnpackedInt64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedUint32) && i < len(y.UnpackedUint32); i++ {
+		if c := equal.CompareOrdered(x.UnpackedUint32[i], y.UnpackedUint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedUint32), len(y.UnpackedUint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedUint64) && i < len(y.UnpackedUint64); i++ {
+		if c := equal.CompareOrdered(x.UnpackedUint64[i], y.UnpackedUint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedUint64), len(y.UnpackedUint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedSint32) && i < len(y.UnpackedSint32); i++ {
+		if c := equal.CompareOrdered(x.UnpackedSint32[i], y.UnpackedSint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedSint32), len(y.UnpackedSint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedSint64) && i < len(y.UnpackedSint64); i++ {
+		if c := equal.CompareOrdered(x.UnpackedSint64[i], y.UnpackedSint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedSint64), len(y.UnpackedSint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedFixed32) && i < len(y.UnpackedFixed32); i++ {
+		if c := equal.CompareOrdered(x.UnpackedFixed32[i], y.UnpackedFixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedFixed32), len(y.UnpackedFixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedFixed64) && i < len(y.UnpackedFixed64); i++ {
+		if c := equal.CompareOrdered(x.UnpackedFixed64[i], y.UnpackedFixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedFixed64), len(y.UnpackedFixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedSfixed32) && i < len(y.UnpackedSfixed32); i++ {
+		if c := equal.CompareOrdered(x.UnpackedSfixed32[i], y.UnpackedSfixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedSfixed32), len(y.UnpackedSfixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedSfixed64) && i < len(y.UnpackedSfixed64); i++ {
+		if c := equal.CompareOrdered(x.UnpackedSfixed64[i], y.UnpackedSfixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedSfixed64), len(y.UnpackedSfixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedFloat) && i < len(y.UnpackedFloat); i++ {
+		if c := equal.CompareFloat64(float64(x.UnpackedFloat[i]), float64(y.UnpackedFloat[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedFloat), len(y.UnpackedFloat)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedDouble) && i < len(y.UnpackedDouble); i++ {
+		if c := equal.CompareFloat64(float64(x.UnpackedDouble[i]), float64(y.UnpackedDouble[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedDouble), len(y.UnpackedDouble)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedBool) && i < len(y.UnpackedBool); i++ {
+		if c := equal.CompareBool(x.UnpackedBool[i], y.UnpackedBool[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedBool), len(y.UnpackedBool)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.UnpackedEnum) && i < len(y.UnpackedEnum); i++ {
+		if c := equal.CompareOrdered(x.UnpackedEnum[i], y.UnpackedEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.UnpackedEnum), len(y.UnpackedEnum)); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestPackedExtensions) Compare(y *TestPackedExtensions) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestUnpackedExtensions) Compare(y *TestUnpackedExtensions) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareExtensions(x.ProtoReflect(), y.ProtoReflect(), equal.FloatNumeric, nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *FooRequest) Compare(y *FooRequest) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *FooResponse) Compare(y *FooResponse) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *WeirdDefault) Compare(y *WeirdDefault) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.WeirdDefault != nil, y.WeirdDefault != nil); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(x.WeirdDefault, y.WeirdDefault); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *RemoteDefault) Compare(y *RemoteDefault) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.Default != nil, y.Default != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Default, y.Default; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Zero != nil, y.Zero != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Zero, y.Zero; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.One != nil, y.One != nil); c != 0 {
+		return c
+	}
+	if p, q := x.One, y.One; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Elevent != nil, y.Elevent != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Elevent, y.Elevent; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Seventeen != nil, y.Seventeen != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Seventeen, y.Seventeen; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Thirtyseven != nil, y.Thirtyseven != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Thirtyseven, y.Thirtyseven; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Sixtyseven != nil, y.Sixtyseven != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Sixtyseven, y.Sixtyseven; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Negative != nil, y.Negative != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Negative, y.Negative; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes_NestedMessage) ChangedFields(y *TestAllTypes_NestedMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes_NestedMessage{}
+	}
+	if y == nil {
+		y = &TestAllTypes_NestedMessage{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.Corecursive, y.Corecursive; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "corecursive")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "corecursive", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "corecursive")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllTypes_OptionalGroup) ChangedFields(y *TestAllTypes_OptionalGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes_OptionalGroup{}
+	}
+	if y == nil {
+		y = &TestAllTypes_OptionalGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
+		paths = append(paths, "same_field_number")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllTypes_RepeatedGroup) ChangedFields(y *TestAllTypes_RepeatedGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes_RepeatedGroup{}
+	}
+	if y == nil {
+		y = &TestAllTypes_RepeatedGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllTypes_OneofGroup) ChangedFields(y *TestAllTypes_OneofGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes_OneofGroup{}
+	}
+	if y == nil {
+		y = &TestAllTypes_OneofGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if !equal.OptionalEqual(x.B, y.B) {
+		paths = append(paths, "b")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllTypes) ChangedFields(y *TestAllTypes) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes{}
+	}
+	if y == nil {
+		y = &TestAllTypes{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
+		paths = append(paths, "optional_int32")
+	}
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
+		paths = append(paths, "optional_int64")
+	}
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
+		paths = append(paths, "optional_uint32")
+	}
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
+		paths = append(paths, "optional_uint64")
+	}
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
+		paths = append(paths, "optional_sint32")
+	}
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
+		paths = append(paths, "optional_sint64")
+	}
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
+		paths = append(paths, "optional_fixed32")
+	}
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
+		paths = append(paths, "optional_fixed64")
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
+		paths = append(paths, "optional_sfixed32")
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
+		paths = append(paths, "optional_sfixed64")
+	}
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
+		paths = append(paths, "optional_float")
+	}
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
+		paths = append(paths, "optional_double")
+	}
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
+		paths = append(paths, "optional_bool")
+	}
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
+		paths = append(paths, "optional_string")
+	}
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
+		paths = append(paths, "optional_bytes")
+	}
+	if p, q := x.Optionalgroup, y.Optionalgroup; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optionalgroup")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optionalgroup", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optionalgroup")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	if p, q := x.OptionalForeignMessage, y.OptionalForeignMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_foreign_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_foreign_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_foreign_message")
+	}
+	if p, q := x.OptionalImportMessage, y.OptionalImportMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_import_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_import_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_import_message")
+	}
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
+		paths = append(paths, "optional_nested_enum")
+	}
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
+		paths = append(paths, "optional_foreign_enum")
+	}
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
+		paths = append(paths, "optional_import_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedInt32, y.RepeatedInt32) {
+		paths = append(paths, "repeated_int32")
+	}
+	if !equal.SliceEqual(x.RepeatedInt64, y.RepeatedInt64) {
+		paths = append(paths, "repeated_int64")
+	}
+	if !equal.SliceEqual(x.RepeatedUint32, y.RepeatedUint32) {
+		paths = append(paths, "repeated_uint32")
+	}
+	if !equal.SliceEqual(x.RepeatedUint64, y.RepeatedUint64) {
+		paths = append(paths, "repeated_uint64")
+	}
+	if !equal.SliceEqual(x.RepeatedSint32, y.RepeatedSint32) {
+		paths = append(paths, "repeated_sint32")
+	}
+	if !equal.SliceEqual(x.RepeatedSint64, y.RepeatedSint64) {
+		paths = append(paths, "repeated_sint64")
+	}
+	if !equal.SliceEqual(x.RepeatedFixed32, y.RepeatedFixed32) {
+		paths = append(paths, "repeated_fixed32")
+	}
+	if !equal.SliceEqual(x.RepeatedFixed64, y.RepeatedFixed64) {
+		paths = append(paths, "repeated_fixed64")
+	}
+	if !equal.SliceEqual(x.RepeatedSfixed32, y.RepeatedSfixed32) {
+		paths = append(paths, "repeated_sfixed32")
+	}
+	if !equal.SliceEqual(x.RepeatedSfixed64, y.RepeatedSfixed64) {
+		paths = append(paths, "repeated_sfixed64")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedFloat, y.RepeatedFloat, equal.FloatEqual[float32]) {
+		paths = append(paths, "repeated_float")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedDouble, y.RepeatedDouble, equal.FloatEqual[float64]) {
+		paths = append(paths, "repeated_double")
+	}
+	if !equal.SliceEqual(x.RepeatedBool, y.RepeatedBool) {
+		paths = append(paths, "repeated_bool")
+	}
+	if !equal.SliceEqual(x.RepeatedString, y.RepeatedString) {
+		paths = append(paths, "repeated_string")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedBytes, y.RepeatedBytes, equal.BytesEqual) {
+		paths = append(paths, "repeated_bytes")
+	}
+	if !equal.SliceEqualFunc(x.Repeatedgroup, y.Repeatedgroup, (*TestAllTypes_RepeatedGroup).Equal) {
+		paths = append(paths, "repeatedgroup")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedNestedMessage, y.RepeatedNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		paths = append(paths, "repeated_nested_message")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedForeignMessage, y.RepeatedForeignMessage, (*ForeignMessage).Equal) {
+		paths = append(paths, "repeated_foreign_message")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedImportmessage, y.RepeatedImportmessage, (*ImportMessage).Equal) {
+		paths = append(paths, "repeated_importmessage")
+	}
+	if !equal.SliceEqual(x.RepeatedNestedEnum, y.RepeatedNestedEnum) {
+		paths = append(paths, "repeated_nested_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedForeignEnum, y.RepeatedForeignEnum) {
+		paths = append(paths, "repeated_foreign_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedImportenum, y.RepeatedImportenum) {
+		paths = append(paths, "repeated_importenum")
+	}
+	if !equal.MapEqual(x.MapInt32Int32, y.MapInt32Int32) {
+		paths = append(paths, "map_int32_int32")
+	}
+	if !equal.MapEqual(x.MapInt64Int64, y.MapInt64Int64) {
+		paths = append(paths, "map_int64_int64")
+	}
+	if !equal.MapEqual(x.MapUint32Uint32, y.MapUint32Uint32) {
+		paths = append(paths, "map_uint32_uint32")
+	}
+	if !equal.MapEqual(x.MapUint64Uint64, y.MapUint64Uint64) {
+		paths = append(paths, "map_uint64_uint64")
+	}
+	if !equal.MapEqual(x.MapSint32Sint32, y.MapSint32Sint32) {
+		paths = append(paths, "map_sint32_sint32")
+	}
+	if !equal.MapEqual(x.MapSint64Sint64, y.MapSint64Sint64) {
+		paths = append(paths, "map_sint64_sint64")
+	}
+	if !equal.MapEqual(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
+		paths = append(paths, "map_fixed32_fixed32")
+	}
+	if !equal.MapEqual(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		paths = append(paths, "map_fixed64_fixed64")
+	}
+	if !equal.MapEqual(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
+		paths = append(paths, "map_sfixed32_sfixed32")
+	}
+	if !equal.MapEqual(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		paths = append(paths, "map_sfixed64_sfixed64")
+	}
+	if !equal.MapEqualFunc(x.MapInt32Float, y.MapInt32Float, equal.FloatEqual[float32]) {
+		paths = append(paths, "map_int32_float")
+	}
+	if !equal.MapEqualFunc(x.MapInt32Double, y.MapInt32Double, equal.FloatEqual[float64]) {
+		paths = append(paths, "map_int32_double")
+	}
+	if !equal.MapEqual(x.MapBoolBool, y.MapBoolBool) {
+		paths = append(paths, "map_bool_bool")
+	}
+	if !equal.MapEqual(x.MapStringString, y.MapStringString) {
+		paths = append(paths, "map_string_string")
+	}
+	if !equal.MapEqualFunc(x.MapStringBytes, y.MapStringBytes, equal.BytesEqual) {
+		paths = append(paths, "map_string_bytes")
+	}
+	if !equal.MapEqualFunc(x.MapStringNestedMessage, y.MapStringNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		paths = append(paths, "map_string_nested_message")
+	}
+	if !equal.MapEqual(x.MapStringNestedEnum, y.MapStringNestedEnum) {
+		paths = append(paths, "map_string_nested_enum")
+	}
+	if !equal.OptionalEqual(x.DefaultInt32, y.DefaultInt32) {
+		paths = append(paths, "default_int32")
+	}
+	if !equal.OptionalEqual(x.DefaultInt64, y.DefaultInt64) {
+		paths = append(paths, "default_int64")
+	}
+	if !equal.OptionalEqual(x.DefaultUint32, y.DefaultUint32) {
+		paths = append(paths, "default_uint32")
+	}
+	if !equal.OptionalEqual(x.DefaultUint64, y.DefaultUint64) {
+		paths = append(paths, "default_uint64")
+	}
+	if !equal.OptionalEqual(x.DefaultSint32, y.DefaultSint32) {
+		paths = append(paths, "default_sint32")
+	}
+	if !equal.OptionalEqual(x.DefaultSint64, y.DefaultSint64) {
+		paths = append(paths, "default_sint64")
+	}
+	if !equal.OptionalEqual(x.DefaultFixed32, y.DefaultFixed32) {
+		paths = append(paths, "default_fixed32")
+	}
+	if !equal.OptionalEqual(x.DefaultFixed64, y.DefaultFixed64) {
+		paths = append(paths, "default_fixed64")
+	}
+	if !equal.OptionalEqual(x.DefaultSfixed32, y.DefaultSfixed32) {
+		paths = append(paths, "default_sfixed32")
+	}
+	if !equal.OptionalEqual(x.DefaultSfixed64, y.DefaultSfixed64) {
+		paths = append(paths, "default_sfixed64")
+	}
+	if !equal.OptionalFloatEqual(x.DefaultFloat, y.DefaultFloat) {
+		paths = append(paths, "default_float")
+	}
+	if !equal.OptionalFloatEqual(x.DefaultDouble, y.DefaultDouble) {
+		paths = append(paths, "default_double")
+	}
+	if !equal.OptionalEqual(x.DefaultBool, y.DefaultBool) {
+		paths = append(paths, "default_bool")
+	}
+	if !equal.OptionalEqual(x.DefaultString, y.DefaultString) {
+		paths = append(paths, "default_string")
+	}
+	if !equal.OptionalBytesEqual(x.DefaultBytes, y.DefaultBytes) {
+		paths = append(paths, "default_bytes")
+	}
+	if !equal.OptionalEqual(x.DefaultNestedEnum, y.DefaultNestedEnum) {
+		paths = append(paths, "default_nested_enum")
+	}
+	if !equal.OptionalEqual(x.DefaultForeignEnum, y.DefaultForeignEnum) {
+		paths = append(paths, "default_foreign_enum")
+	}
+	switch xv := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			paths = append(paths, "oneof_uint32")
+		} else {
+			if xv.OneofUint32 != yv.OneofUint32 {
+				paths = append(paths, "oneof_uint32")
+			}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			paths = append(paths, "oneof_nested_message")
+		} else {
+			if p, q := xv.OneofNestedMessage, yv.OneofNestedMessage; p == nil || q == nil {
+				if p != q {
+					paths = append(paths, "oneof_nested_message")
+				}
+			} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+				paths = equal.AppendNestedPaths(paths, "oneof_nested_message", nested)
+			} else if !p.Equal(q) {
+				paths = append(paths, "oneof_nested_message")
+			}
+		}
+	case *TestAllTypes_OneofString:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofString); !ok {
+			paths = append(paths, "oneof_string")
+		} else {
+			if xv.OneofString != yv.OneofString {
+				paths = append(paths, "oneof_string")
+			}
+		}
+	case *TestAllTypes_OneofBytes:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			paths = append(paths, "oneof_bytes")
+		} else {
+			if string(xv.OneofBytes) != string(yv.OneofBytes) {
+				paths = append(paths, "oneof_bytes")
+			}
+		}
+	case *TestAllTypes_OneofBool:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok {
+			paths = append(paths, "oneof_bool")
+		} else {
+			if xv.OneofBool != yv.OneofBool {
+				paths = append(paths, "oneof_bool")
+			}
+		}
+	case *TestAllTypes_OneofUint64:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			paths = append(paths, "oneof_uint64")
+		} else {
+			if xv.OneofUint64 != yv.OneofUint64 {
+				paths = append(paths, "oneof_uint64")
+			}
+		}
+	case *TestAllTypes_OneofFloat:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			paths = append(paths, "oneof_float")
+		} else {
+			if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
+				paths = append(paths, "oneof_float")
+			}
+		}
+	case *TestAllTypes_OneofDouble:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			paths = append(paths, "oneof_double")
+		} else {
+			if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
+				paths = append(paths, "oneof_double")
+			}
+		}
+	case *TestAllTypes_OneofEnum:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			paths = append(paths, "oneof_enum")
+		} else {
+			if xv.OneofEnum != yv.OneofEnum {
+				paths = append(paths, "oneof_enum")
+			}
+		}
+	case *TestAllTypes_Oneofgroup:
+		if yv, ok := y.OneofField.(*TestAllTypes_Oneofgroup); !ok {
+			paths = append(paths, "oneofgroup")
+		} else {
+			if p, q := xv.Oneofgroup, yv.Oneofgroup; p == nil || q == nil {
+				if p != q {
+					paths = append(paths, "oneofgroup")
+				}
+			} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+				paths = equal.AppendNestedPaths(paths, "oneofgroup", nested)
+			} else if !p.Equal(q) {
+				paths = append(paths, "oneofgroup")
+			}
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			paths = append(paths, "oneof_wrappers_string_value")
+		} else {
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+				paths = append(paths, "oneof_wrappers_string_value")
+			}
+		}
+	}
+	switch y.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			paths = append(paths, "oneof_uint32")
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			paths = append(paths, "oneof_nested_message")
+		}
+	case *TestAllTypes_OneofString:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofString); !ok {
+			paths = append(paths, "oneof_string")
+		}
+	case *TestAllTypes_OneofBytes:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			paths = append(paths, "oneof_bytes")
+		}
+	case *TestAllTypes_OneofBool:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofBool); !ok {
+			paths = append(paths, "oneof_bool")
+		}
+	case *TestAllTypes_OneofUint64:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			paths = append(paths, "oneof_uint64")
+		}
+	case *TestAllTypes_OneofFloat:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			paths = append(paths, "oneof_float")
+		}
+	case *TestAllTypes_OneofDouble:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			paths = append(paths, "oneof_double")
+		}
+	case *TestAllTypes_OneofEnum:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			paths = append(paths, "oneof_enum")
+		}
+	case *TestAllTypes_Oneofgroup:
+		if _, ok := x.OneofField.(*TestAllTypes_Oneofgroup); !ok {
+			paths = append(paths, "oneofgroup")
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			paths = append(paths, "oneof_wrappers_string_value")
+		}
+	}
+	switch xv := x.OneofOptional.(type) {
+	case *TestAllTypes_OneofOptionalUint32:
+		if yv, ok := y.OneofOptional.(*TestAllTypes_OneofOptionalUint32); !ok {
+			paths = append(paths, "oneof_optional_uint32")
+		} else {
+			if xv.OneofOptionalUint32 != yv.OneofOptionalUint32 {
+				paths = append(paths, "oneof_optional_uint32")
+			}
+		}
+	}
+	switch y.OneofOptional.(type) {
+	case *TestAllTypes_OneofOptionalUint32:
+		if _, ok := x.OneofOptional.(*TestAllTypes_OneofOptionalUint32); !ok {
+			paths = append(paths, "oneof_optional_uint32")
+		}
+	}
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
+		paths = append(paths, "any")
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		paths = append(paths, "duration")
+	}
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
+		paths = append(paths, "empty")
+	}
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		paths = append(paths, "timestamp")
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_bool_value")
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
+		paths = append(paths, "wrappers_bytes_value")
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		paths = append(paths, "wrappers_double_value")
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		paths = append(paths, "wrappers_float_value")
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_int32_value")
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_int64_value")
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_string_value")
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_uint32_value")
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_uint64_value")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestDeprecatedMessage) ChangedFields(y *TestDeprecatedMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestDeprecatedMessage{}
+	}
+	if y == nil {
+		y = &TestDeprecatedMessage{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.DeprecatedInt32, y.DeprecatedInt32) {
+		paths = append(paths, "deprecated_int32")
+	}
+	switch xv := x.DeprecatedOneof.(type) {
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		if yv, ok := y.DeprecatedOneof.(*TestDeprecatedMessage_DeprecatedOneofField); !ok {
+			paths = append(paths, "deprecated_oneof_field")
+		} else {
+			if xv.DeprecatedOneofField != yv.DeprecatedOneofField {
+				paths = append(paths, "deprecated_oneof_field")
+			}
+		}
+	}
+	switch y.DeprecatedOneof.(type) {
+	case *TestDeprecatedMessage_DeprecatedOneofField:
+		if _, ok := x.DeprecatedOneof.(*TestDeprecatedMessage_DeprecatedOneofField); !ok {
+			paths = append(paths, "deprecated_oneof_field")
+		}
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *ForeignMessage) ChangedFields(y *ForeignMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &ForeignMessage{}
+	}
+	if y == nil {
+		y = &ForeignMessage{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.C, y.C) {
+		paths = append(paths, "c")
+	}
+	if !equal.OptionalEqual(x.D, y.D) {
+		paths = append(paths, "d")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestReservedFields) ChangedFields(y *TestReservedFields) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestReservedFields{}
+	}
+	if y == nil {
+		y = &TestReservedFields{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllExtensions_NestedMessage) ChangedFields(y *TestAllExtensions_NestedMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllExtensions_NestedMessage{}
+	}
+	if y == nil {
+		y = &TestAllExtensions_NestedMessage{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.Corecursive, y.Corecursive; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "corecursive")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "corecursive", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "corecursive")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllExtensions) ChangedFields(y *TestAllExtensions) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllExtensions{}
+	}
+	if y == nil {
+		y = &TestAllExtensions{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *OptionalGroup) ChangedFields(y *OptionalGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &OptionalGroup{}
+	}
+	if y == nil {
+		y = &OptionalGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if !equal.OptionalEqual(x.SameFieldNumber, y.SameFieldNumber) {
+		paths = append(paths, "same_field_number")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *RepeatedGroup) ChangedFields(y *RepeatedGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &RepeatedGroup{}
+	}
+	if y == nil {
+		y = &RepeatedGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestNestedExtension) ChangedFields(y *TestNestedExtension) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestNestedExtension{}
+	}
+	if y == nil {
+		y = &TestNestedExtension{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestRequired) ChangedFields(y *TestRequired) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestRequired{}
+	}
+	if y == nil {
+		y = &TestRequired{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.RequiredField, y.RequiredField) {
+		paths = append(paths, "required_field")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestRequiredForeign) ChangedFields(y *TestRequiredForeign) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestRequiredForeign{}
+	}
+	if y == nil {
+		y = &TestRequiredForeign{}
+	}
+	var paths []string
+	if p, q := x.OptionalMessage, y.OptionalMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_message")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedMessage, y.RepeatedMessage, (*TestRequired).Equal) {
+		paths = append(paths, "repeated_message")
+	}
+	if !equal.MapEqualFunc(x.MapMessage, y.MapMessage, (*TestRequired).Equal) {
+		paths = append(paths, "map_message")
+	}
+	switch xv := x.OneofField.(type) {
+	case *TestRequiredForeign_OneofMessage:
+		if yv, ok := y.OneofField.(*TestRequiredForeign_OneofMessage); !ok {
+			paths = append(paths, "oneof_message")
+		} else {
+			if p, q := xv.OneofMessage, yv.OneofMessage; p == nil || q == nil {
+				if p != q {
+					paths = append(paths, "oneof_message")
+				}
+			} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+				paths = equal.AppendNestedPaths(paths, "oneof_message", nested)
+			} else if !p.Equal(q) {
+				paths = append(paths, "oneof_message")
+			}
+		}
+	}
+	switch y.OneofField.(type) {
+	case *TestRequiredForeign_OneofMessage:
+		if _, ok := x.OneofField.(*TestRequiredForeign_OneofMessage); !ok {
+			paths = append(paths, "oneof_message")
+		}
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestRequiredGroupFields_OptionalGroup) ChangedFields(y *TestRequiredGroupFields_OptionalGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestRequiredGroupFields_OptionalGroup{}
+	}
+	if y == nil {
+		y = &TestRequiredGroupFields_OptionalGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestRequiredGroupFields_RepeatedGroup) ChangedFields(y *TestRequiredGroupFields_RepeatedGroup) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestRequiredGroupFields_RepeatedGroup{}
+	}
+	if y == nil {
+		y = &TestRequiredGroupFields_RepeatedGroup{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestRequiredGroupFields) ChangedFields(y *TestRequiredGroupFields) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestRequiredGroupFields{}
+	}
+	if y == nil {
+		y = &TestRequiredGroupFields{}
+	}
+	var paths []string
+	if p, q := x.Optionalgroup, y.Optionalgroup; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optionalgroup")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optionalgroup", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optionalgroup")
+	}
+	if !equal.SliceEqualFunc(x.Repeatedgroup, y.Repeatedgroup, (*TestRequiredGroupFields_RepeatedGroup).Equal) {
+		paths = append(paths, "repeatedgroup")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestWeak) ChangedFields(y *TestWeak) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestWeak{}
+	}
+	if y == nil {
+		y = &TestWeak{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestPackedTypes) ChangedFields(y *TestPackedTypes) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestPackedTypes{}
+	}
+	if y == nil {
+		y = &TestPackedTypes{}
+	}
+	var paths []string
+	if !equal.SliceEqual(x.PackedInt32, y.PackedInt32) {
+		paths = append(paths, "packed_int32")
+	}
+	if !equal.SliceEqual(x.PackedInt64, y.PackedInt64) {
+		paths = append(paths, "packed_int64")
+	}
+	if !equal.SliceEqual(x.PackedUint32, y.PackedUint32) {
+		paths = append(paths, "packed_uint32")
+	}
+	if !equal.SliceEqual(x.PackedUint64, y.PackedUint64) {
+		paths = append(paths, "packed_uint64")
+	}
+	if !equal.SliceEqual(x.PackedSint32, y.PackedSint32) {
+		paths = append(paths, "packed_sint32")
+	}
+	if !equal.SliceEqual(x.PackedSint64, y.PackedSint64) {
+		paths = append(paths, "packed_sint64")
+	}
+	if !equal.SliceEqual(x.PackedFixed32, y.PackedFixed32) {
+		paths = append(paths, "packed_fixed32")
+	}
+	if !equal.SliceEqual(x.PackedFixed64, y.PackedFixed64) {
+		paths = append(paths, "packed_fixed64")
+	}
+	if !equal.SliceEqual(x.PackedSfixed32, y.PackedSfixed32) {
+		paths = append(paths, "packed_sfixed32")
+	}
+	if !equal.SliceEqual(x.PackedSfixed64, y.PackedSfixed64) {
+		paths = append(paths, "packed_sfixed64")
+	}
+	if !equal.SliceEqualFunc(x.PackedFloat, y.PackedFloat, equal.FloatEqual[float32]) {
+		paths = append(paths, "packed_float")
+	}
+	if !equal.SliceEqualFunc(x.PackedDouble, y.PackedDouble, equal.FloatEqual[float64]) {
+		paths = append(paths, "packed_double")
+	}
+	if !equal.SliceEqual(x.PackedBool, y.PackedBool) {
+		paths = append(paths, "packed_bool")
+	}
+	if !equal.SliceEqual(x.PackedEnum, y.PackedEnum) {
+		paths = append(paths, "packed_enum")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestUnpackedTypes) ChangedFields(y *TestUnpackedTypes) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestUnpackedTypes{}
+	}
+	if y == nil {
+		y = &TestUnpackedTypes{}
+	}
+	var paths []string
+	if !equal.SliceEqual(x.UnpackedInt32, y.UnpackedInt32) {
+		paths = append(paths, "unpacked_int32")
+	}
+	if !equal.SliceEqual(x.UnpackedInt64, y.UnpackedInt64) {
+		paths = append(paths, "unpacked_int64")
+	}
+	if !equal.SliceEqual(x.UnpackedUint32, y.UnpackedUint32) {
+		paths = append(paths, "unpacked_uint32")
+	}
+	if !equal.SliceEqual(x.UnpackedUint64, y.UnpackedUint64) {
+		paths = append(paths, "unpacked_uint64")
+	}
+	if !equal.SliceEqual(x.UnpackedSint32, y.UnpackedSint32) {
+		paths = append(paths, "unpacked_sint32")
+	}
+	if !equal.SliceEqual(x.UnpackedSint64, y.UnpackedSint64) {
+		paths = append(paths, "unpacked_sint64")
+	}
+	if !equal.SliceEqual(x.UnpackedFixed32, y.UnpackedFixed32) {
+		paths = append(paths, "unpacked_fixed32")
+	}
+	if !equal.SliceEqual(x.UnpackedFixed64, y.UnpackedFixed64) {
+		paths = append(paths, "unpacked_fixed64")
+	}
+	if !equal.SliceEqual(x.UnpackedSfixed32, y.UnpackedSfixed32) {
+		paths = append(paths, "unpacked_sfixed32")
+	}
+	if !equal.SliceEqual(x.UnpackedSfixed64, y.UnpackedSfixed64) {
+		paths = append(paths, "unpacked_sfixed64")
+	}
+	if !equal.SliceEqualFunc(x.UnpackedFloat, y.UnpackedFloat, equal.FloatEqual[float32]) {
+		paths = append(paths, "unpacked_float")
+	}
+	if !equal.SliceEqualFunc(x.UnpackedDouble, y.UnpackedDouble, equal.FloatEqual[float64]) {
+		paths = append(paths, "unpacked_double")
+	}
+	if !equal.SliceEqual(x.UnpackedBool, y.UnpackedBool) {
+		paths = append(paths, "unpacked_bool")
+	}
+	if !equal.SliceEqual(x.UnpackedEnum, y.UnpackedEnum) {
+		paths = append(paths, "unpacked_enum")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestPackedExtensions) ChangedFields(y *TestPackedExtensions) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestPackedExtensions{}
+	}
+	if y == nil {
+		y = &TestPackedExtensions{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestUnpackedExtensions) ChangedFields(y *TestUnpackedExtensions) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestUnpackedExtensions{}
+	}
+	if y == nil {
+		y = &TestUnpackedExtensions{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *FooRequest) ChangedFields(y *FooRequest) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &FooRequest{}
+	}
+	if y == nil {
+		y = &FooRequest{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *FooResponse) ChangedFields(y *FooResponse) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &FooResponse{}
+	}
+	if y == nil {
+		y = &FooResponse{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *WeirdDefault) ChangedFields(y *WeirdDefault) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &WeirdDefault{}
+	}
+	if y == nil {
+		y = &WeirdDefault{}
+	}
+	var paths []string
+	if !equal.OptionalBytesEqual(x.WeirdDefault, y.WeirdDefault) {
+		paths = append(paths, "weird_default")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *RemoteDefault) ChangedFields(y *RemoteDefault) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &RemoteDefault{}
+	}
+	if y == nil {
+		y = &RemoteDefault{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.Default, y.Default) {
+		paths = append(paths, "default")
+	}
+	if !equal.OptionalEqual(x.Zero, y.Zero) {
+		paths = append(paths, "zero")
+	}
+	if !equal.OptionalEqual(x.One, y.One) {
+		paths = append(paths, "one")
+	}
+	if !equal.OptionalEqual(x.Elevent, y.Elevent) {
+		paths = append(paths, "elevent")
+	}
+	if !equal.OptionalEqual(x.Seventeen, y.Seventeen) {
+		paths = append(paths, "seventeen")
+	}
+	if !equal.OptionalEqual(x.Thirtyseven, y.Thirtyseven) {
+		paths = append(paths, "thirtyseven")
+	}
+	if !equal.OptionalEqual(x.Sixtyseven, y.Sixtyseven) {
+		paths = append(paths, "sixtyseven")
+	}
+	if !equal.OptionalEqual(x.Negative, y.Negative) {
+		paths = append(paths, "negative")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -3,6 +3,12 @@
 
 package weak2
 
+import (
+	equal "github.com/melias122/protoc-gen-go-equal/equal"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maphash "hash/maphash"
+)
+
 func (x *WeakImportMessage2) Equal(y *WeakImportMessage2) bool {
 	if x == y {
 		return true
@@ -10,8 +16,87 @@ func (x *WeakImportMessage2) Equal(y *WeakImportMessage2) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
+		return false
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
 }
+
+func init() {
+	equal.Register("goproto.proto.test.weak.WeakImportMessage2", (*WeakImportMessage2).Equal)
+}
+
+func (x *WeakImportMessage2) Diff(y *WeakImportMessage2) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *WeakImportMessage2) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *WeakImportMessage2) Compare(y *WeakImportMessage2) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *WeakImportMessage2) ChangedFields(y *WeakImportMessage2) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &WeakImportMessage2{}
+	}
+	if y == nil {
+		y = &WeakImportMessage2{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -4,9 +4,12 @@
 package test3
 
 import (
+	bytes "bytes"
+	equal "github.com/melias122/protoc-gen-go-equal/equal"
 	other "github.com/melias122/protoc-gen-go-equal/internal/testprotos/other"
 	proto "google.golang.org/protobuf/proto"
-	math "math"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maphash "hash/maphash"
 )
 
 func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
@@ -16,12 +19,15 @@ func (x *TestAllTypes_NestedMessage) Equal(y *TestAllTypes_NestedMessage) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
-	if p, q := x.A, y.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.A, y.A) {
 		return false
 	}
 	if !x.Corecursive.Equal(y.Corecursive) {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
 
@@ -62,10 +68,10 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if x.SingularSfixed64 != y.SingularSfixed64 {
 		return false
 	}
-	if (math.IsNaN(float64(x.SingularFloat)) && !math.IsNaN(float64(y.SingularFloat)) || !math.IsNaN(float64(x.SingularFloat)) && math.IsNaN(float64(y.SingularFloat))) || (!math.IsNaN(float64(x.SingularFloat)) && !math.IsNaN(float64(y.SingularFloat)) && x.SingularFloat != y.SingularFloat) {
+	if !equal.FloatEqual(x.SingularFloat, y.SingularFloat) {
 		return false
 	}
-	if (math.IsNaN(float64(x.SingularDouble)) && !math.IsNaN(float64(y.SingularDouble)) || !math.IsNaN(float64(x.SingularDouble)) && math.IsNaN(float64(y.SingularDouble))) || (!math.IsNaN(float64(x.SingularDouble)) && !math.IsNaN(float64(y.SingularDouble)) && x.SingularDouble != y.SingularDouble) {
+	if !equal.FloatEqual(x.SingularDouble, y.SingularDouble) {
 		return false
 	}
 	if x.SingularBool != y.SingularBool {
@@ -95,49 +101,49 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if x.SingularImportEnum != y.SingularImportEnum {
 		return false
 	}
-	if p, q := x.OptionalInt32, y.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
 		return false
 	}
-	if p, q := x.OptionalInt64, y.OptionalInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
 		return false
 	}
-	if p, q := x.OptionalUint32, y.OptionalUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
 		return false
 	}
-	if p, q := x.OptionalUint64, y.OptionalUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
 		return false
 	}
-	if p, q := x.OptionalSint32, y.OptionalSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
 		return false
 	}
-	if p, q := x.OptionalSint64, y.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
 		return false
 	}
-	if p, q := x.OptionalFixed32, y.OptionalFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
 		return false
 	}
-	if p, q := x.OptionalFixed64, y.OptionalFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
 		return false
 	}
-	if p, q := x.OptionalSfixed32, y.OptionalSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
 		return false
 	}
-	if p, q := x.OptionalSfixed64, y.OptionalSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
 		return false
 	}
-	if p, q := x.OptionalFloat, y.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
 		return false
 	}
-	if p, q := x.OptionalDouble, y.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || (math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) || !math.IsNaN(float64(*p)) && math.IsNaN(float64(*q))) || (!math.IsNaN(float64(*p)) && !math.IsNaN(float64(*q)) && *p != *q))) {
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
 		return false
 	}
-	if p, q := x.OptionalBool, y.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
 		return false
 	}
-	if p, q := x.OptionalString, y.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
 		return false
 	}
-	if p, q := x.OptionalBytes, y.OptionalBytes; (p == nil && q != nil) || (p != nil && (q == nil || string(p) != string(q))) {
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
 		return false
 	}
 	if !x.OptionalNestedMessage.Equal(y.OptionalNestedMessage) {
@@ -149,427 +155,239 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if !x.OptionalImportMessage.Equal(y.OptionalImportMessage) {
 		return false
 	}
-	if p, q := x.OptionalNestedEnum, y.OptionalNestedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
 		return false
 	}
-	if p, q := x.OptionalForeignEnum, y.OptionalForeignEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
 		return false
 	}
-	if p, q := x.OptionalImportEnum, y.OptionalImportEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
 		return false
 	}
-	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+	if !equal.SliceEqual(x.RepeatedInt32, y.RepeatedInt32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedInt32); i++ {
-		if x.RepeatedInt32[i] != y.RepeatedInt32[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+	if !equal.SliceEqual(x.RepeatedInt64, y.RepeatedInt64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedInt64); i++ {
-		if x.RepeatedInt64[i] != y.RepeatedInt64[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+	if !equal.SliceEqual(x.RepeatedUint32, y.RepeatedUint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedUint32); i++ {
-		if x.RepeatedUint32[i] != y.RepeatedUint32[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+	if !equal.SliceEqual(x.RepeatedUint64, y.RepeatedUint64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedUint64); i++ {
-		if x.RepeatedUint64[i] != y.RepeatedUint64[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+	if !equal.SliceEqual(x.RepeatedSint32, y.RepeatedSint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSint32); i++ {
-		if x.RepeatedSint32[i] != y.RepeatedSint32[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+	if !equal.SliceEqual(x.RepeatedSint64, y.RepeatedSint64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSint64); i++ {
-		if x.RepeatedSint64[i] != y.RepeatedSint64[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+	if !equal.SliceEqual(x.RepeatedFixed32, y.RepeatedFixed32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFixed32); i++ {
-		if x.RepeatedFixed32[i] != y.RepeatedFixed32[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+	if !equal.SliceEqual(x.RepeatedFixed64, y.RepeatedFixed64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFixed64); i++ {
-		if x.RepeatedFixed64[i] != y.RepeatedFixed64[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+	if !equal.SliceEqual(x.RepeatedSfixed32, y.RepeatedSfixed32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSfixed32); i++ {
-		if x.RepeatedSfixed32[i] != y.RepeatedSfixed32[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+	if !equal.SliceEqual(x.RepeatedSfixed64, y.RepeatedSfixed64) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedSfixed64); i++ {
-		if x.RepeatedSfixed64[i] != y.RepeatedSfixed64[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+	if !equal.SliceEqualFunc(x.RepeatedFloat, y.RepeatedFloat, equal.FloatEqual[float32]) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedFloat); i++ {
-		if (math.IsNaN(float64(x.RepeatedFloat[i])) && !math.IsNaN(float64(y.RepeatedFloat[i])) || !math.IsNaN(float64(x.RepeatedFloat[i])) && math.IsNaN(float64(y.RepeatedFloat[i]))) || (!math.IsNaN(float64(x.RepeatedFloat[i])) && !math.IsNaN(float64(y.RepeatedFloat[i])) && x.RepeatedFloat[i] != y.RepeatedFloat[i]) {
-			return false
-		}
-	}
-	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+	if !equal.SliceEqualFunc(x.RepeatedDouble, y.RepeatedDouble, equal.FloatEqual[float64]) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedDouble); i++ {
-		if (math.IsNaN(float64(x.RepeatedDouble[i])) && !math.IsNaN(float64(y.RepeatedDouble[i])) || !math.IsNaN(float64(x.RepeatedDouble[i])) && math.IsNaN(float64(y.RepeatedDouble[i]))) || (!math.IsNaN(float64(x.RepeatedDouble[i])) && !math.IsNaN(float64(y.RepeatedDouble[i])) && x.RepeatedDouble[i] != y.RepeatedDouble[i]) {
-			return false
-		}
-	}
-	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+	if !equal.SliceEqual(x.RepeatedBool, y.RepeatedBool) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedBool); i++ {
-		if x.RepeatedBool[i] != y.RepeatedBool[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedString) != len(y.RepeatedString) {
+	if !equal.SliceEqual(x.RepeatedString, y.RepeatedString) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedString); i++ {
-		if x.RepeatedString[i] != y.RepeatedString[i] {
-			return false
-		}
-	}
-	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+	if !equal.SliceEqualFunc(x.RepeatedBytes, y.RepeatedBytes, equal.BytesEqual) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedBytes); i++ {
-		if string(x.RepeatedBytes[i]) != string(y.RepeatedBytes[i]) {
-			return false
-		}
-	}
-	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+	if !equal.SliceEqualFunc(x.RepeatedNestedMessage, y.RepeatedNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedNestedMessage); i++ {
-		if !x.RepeatedNestedMessage[i].Equal(y.RepeatedNestedMessage[i]) {
-			return false
-		}
-	}
-	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+	if !equal.SliceEqualFunc(x.RepeatedForeignMessage, y.RepeatedForeignMessage, (*ForeignMessage).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedForeignMessage); i++ {
-		if !x.RepeatedForeignMessage[i].Equal(y.RepeatedForeignMessage[i]) {
-			return false
-		}
-	}
-	if len(x.RepeatedImportmessage) != len(y.RepeatedImportmessage) {
+	if !equal.SliceEqualFunc(x.RepeatedImportmessage, y.RepeatedImportmessage, (*ImportMessage).Equal) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedImportmessage); i++ {
-		if !x.RepeatedImportmessage[i].Equal(y.RepeatedImportmessage[i]) {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedNestedEnum, y.RepeatedNestedEnum) {
+		return false
 	}
-	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+	if !equal.SliceEqual(x.RepeatedForeignEnum, y.RepeatedForeignEnum) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedNestedEnum); i++ {
-		if x.RepeatedNestedEnum[i] != y.RepeatedNestedEnum[i] {
-			return false
-		}
+	if !equal.SliceEqual(x.RepeatedImportenum, y.RepeatedImportenum) {
+		return false
 	}
-	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+	if !equal.MapEqual(x.MapInt32Int32, y.MapInt32Int32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedForeignEnum); i++ {
-		if x.RepeatedForeignEnum[i] != y.RepeatedForeignEnum[i] {
-			return false
-		}
+	if !equal.MapEqual(x.MapInt64Int64, y.MapInt64Int64) {
+		return false
 	}
-	if len(x.RepeatedImportenum) != len(y.RepeatedImportenum) {
+	if !equal.MapEqual(x.MapUint32Uint32, y.MapUint32Uint32) {
 		return false
 	}
-	for i := 0; i < len(x.RepeatedImportenum); i++ {
-		if x.RepeatedImportenum[i] != y.RepeatedImportenum[i] {
-			return false
-		}
+	if !equal.MapEqual(x.MapUint64Uint64, y.MapUint64Uint64) {
+		return false
 	}
-	if len(x.MapInt32Int32) != len(y.MapInt32Int32) {
+	if !equal.MapEqual(x.MapSint32Sint32, y.MapSint32Sint32) {
 		return false
 	}
-	for k := range x.MapInt32Int32 {
-		_, ok := y.MapInt32Int32[k]
-		if !ok {
-			return false
-		}
-		if x.MapInt32Int32[k] != y.MapInt32Int32[k] {
-			return false
-		}
+	if !equal.MapEqual(x.MapSint64Sint64, y.MapSint64Sint64) {
+		return false
 	}
-	if len(x.MapInt64Int64) != len(y.MapInt64Int64) {
+	if !equal.MapEqual(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
 		return false
 	}
-	for k := range x.MapInt64Int64 {
-		_, ok := y.MapInt64Int64[k]
-		if !ok {
-			return false
-		}
-		if x.MapInt64Int64[k] != y.MapInt64Int64[k] {
-			return false
-		}
+	if !equal.MapEqual(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		return false
 	}
-	if len(x.MapUint32Uint32) != len(y.MapUint32Uint32) {
+	if !equal.MapEqual(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
 		return false
 	}
-	for k := range x.MapUint32Uint32 {
-		_, ok := y.MapUint32Uint32[k]
-		if !ok {
-			return false
-		}
-		if x.MapUint32Uint32[k] != y.MapUint32Uint32[k] {
-			return false
-		}
+	if !equal.MapEqual(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		return false
 	}
-	if len(x.MapUint64Uint64) != len(y.MapUint64Uint64) {
+	if !equal.MapEqualFunc(x.MapInt32Float, y.MapInt32Float, equal.FloatEqual[float32]) {
 		return false
 	}
-	for k := range x.MapUint64Uint64 {
-		_, ok := y.MapUint64Uint64[k]
-		if !ok {
-			return false
-		}
-		if x.MapUint64Uint64[k] != y.MapUint64Uint64[k] {
-			return false
-		}
+	if !equal.MapEqualFunc(x.MapInt32Double, y.MapInt32Double, equal.FloatEqual[float64]) {
+		return false
 	}
-	if len(x.MapSint32Sint32) != len(y.MapSint32Sint32) {
+	if !equal.MapEqual(x.MapBoolBool, y.MapBoolBool) {
 		return false
 	}
-	for k := range x.MapSint32Sint32 {
-		_, ok := y.MapSint32Sint32[k]
-		if !ok {
-			return false
-		}
-		if x.MapSint32Sint32[k] != y.MapSint32Sint32[k] {
-			return false
-		}
+	if !equal.MapEqual(x.MapStringString, y.MapStringString) {
+		return false
 	}
-	if len(x.MapSint64Sint64) != len(y.MapSint64Sint64) {
+	if !equal.MapEqualFunc(x.MapStringBytes, y.MapStringBytes, equal.BytesEqual) {
 		return false
 	}
-	for k := range x.MapSint64Sint64 {
-		_, ok := y.MapSint64Sint64[k]
-		if !ok {
-			return false
-		}
-		if x.MapSint64Sint64[k] != y.MapSint64Sint64[k] {
-			return false
-		}
+	if !equal.MapEqualFunc(x.MapStringNestedMessage, y.MapStringNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		return false
 	}
-	if len(x.MapFixed32Fixed32) != len(y.MapFixed32Fixed32) {
+	if !equal.MapEqual(x.MapStringNestedEnum, y.MapStringNestedEnum) {
 		return false
 	}
-	for k := range x.MapFixed32Fixed32 {
-		_, ok := y.MapFixed32Fixed32[k]
-		if !ok {
-			return false
-		}
-		if x.MapFixed32Fixed32[k] != y.MapFixed32Fixed32[k] {
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
 			return false
 		}
-	}
-	if len(x.MapFixed64Fixed64) != len(y.MapFixed64Fixed64) {
-		return false
-	}
-	for k := range x.MapFixed64Fixed64 {
-		_, ok := y.MapFixed64Fixed64[k]
+	case *TestAllTypes_OneofUint32:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofUint32)
 		if !ok {
 			return false
 		}
-		if x.MapFixed64Fixed64[k] != y.MapFixed64Fixed64[k] {
+		if xv.OneofUint32 != yv.OneofUint32 {
 			return false
 		}
-	}
-	if len(x.MapSfixed32Sfixed32) != len(y.MapSfixed32Sfixed32) {
-		return false
-	}
-	for k := range x.MapSfixed32Sfixed32 {
-		_, ok := y.MapSfixed32Sfixed32[k]
+	case *TestAllTypes_OneofNestedMessage:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage)
 		if !ok {
 			return false
 		}
-		if x.MapSfixed32Sfixed32[k] != y.MapSfixed32Sfixed32[k] {
+		if !xv.OneofNestedMessage.Equal(yv.OneofNestedMessage) {
 			return false
 		}
-	}
-	if len(x.MapSfixed64Sfixed64) != len(y.MapSfixed64Sfixed64) {
-		return false
-	}
-	for k := range x.MapSfixed64Sfixed64 {
-		_, ok := y.MapSfixed64Sfixed64[k]
+	case *TestAllTypes_OneofString:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofString)
 		if !ok {
 			return false
 		}
-		if x.MapSfixed64Sfixed64[k] != y.MapSfixed64Sfixed64[k] {
+		if xv.OneofString != yv.OneofString {
 			return false
 		}
-	}
-	if len(x.MapInt32Float) != len(y.MapInt32Float) {
-		return false
-	}
-	for k := range x.MapInt32Float {
-		_, ok := y.MapInt32Float[k]
+	case *TestAllTypes_OneofBytes:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofBytes)
 		if !ok {
 			return false
 		}
-		if (math.IsNaN(float64(x.MapInt32Float[k])) && !math.IsNaN(float64(y.MapInt32Float[k])) || !math.IsNaN(float64(x.MapInt32Float[k])) && math.IsNaN(float64(y.MapInt32Float[k]))) || (!math.IsNaN(float64(x.MapInt32Float[k])) && !math.IsNaN(float64(y.MapInt32Float[k])) && x.MapInt32Float[k] != y.MapInt32Float[k]) {
+		if string(xv.OneofBytes) != string(yv.OneofBytes) {
 			return false
 		}
-	}
-	if len(x.MapInt32Double) != len(y.MapInt32Double) {
-		return false
-	}
-	for k := range x.MapInt32Double {
-		_, ok := y.MapInt32Double[k]
+	case *TestAllTypes_OneofBool:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofBool)
 		if !ok {
 			return false
 		}
-		if (math.IsNaN(float64(x.MapInt32Double[k])) && !math.IsNaN(float64(y.MapInt32Double[k])) || !math.IsNaN(float64(x.MapInt32Double[k])) && math.IsNaN(float64(y.MapInt32Double[k]))) || (!math.IsNaN(float64(x.MapInt32Double[k])) && !math.IsNaN(float64(y.MapInt32Double[k])) && x.MapInt32Double[k] != y.MapInt32Double[k]) {
+		if xv.OneofBool != yv.OneofBool {
 			return false
 		}
-	}
-	if len(x.MapBoolBool) != len(y.MapBoolBool) {
-		return false
-	}
-	for k := range x.MapBoolBool {
-		_, ok := y.MapBoolBool[k]
+	case *TestAllTypes_OneofUint64:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofUint64)
 		if !ok {
 			return false
 		}
-		if x.MapBoolBool[k] != y.MapBoolBool[k] {
+		if xv.OneofUint64 != yv.OneofUint64 {
 			return false
 		}
-	}
-	if len(x.MapStringString) != len(y.MapStringString) {
-		return false
-	}
-	for k := range x.MapStringString {
-		_, ok := y.MapStringString[k]
+	case *TestAllTypes_OneofFloat:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofFloat)
 		if !ok {
 			return false
 		}
-		if x.MapStringString[k] != y.MapStringString[k] {
+		if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
 			return false
 		}
-	}
-	if len(x.MapStringBytes) != len(y.MapStringBytes) {
-		return false
-	}
-	for k := range x.MapStringBytes {
-		_, ok := y.MapStringBytes[k]
+	case *TestAllTypes_OneofDouble:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofDouble)
 		if !ok {
 			return false
 		}
-		if string(x.MapStringBytes[k]) != string(y.MapStringBytes[k]) {
+		if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
 			return false
 		}
-	}
-	if len(x.MapStringNestedMessage) != len(y.MapStringNestedMessage) {
-		return false
-	}
-	for k := range x.MapStringNestedMessage {
-		_, ok := y.MapStringNestedMessage[k]
+	case *TestAllTypes_OneofEnum:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofEnum)
 		if !ok {
 			return false
 		}
-		if !x.MapStringNestedMessage[k].Equal(y.MapStringNestedMessage[k]) {
+		if xv.OneofEnum != yv.OneofEnum {
 			return false
 		}
-	}
-	if len(x.MapStringNestedEnum) != len(y.MapStringNestedEnum) {
-		return false
-	}
-	for k := range x.MapStringNestedEnum {
-		_, ok := y.MapStringNestedEnum[k]
+	case *TestAllTypes_OneofWrappersStringValue:
+		yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue)
 		if !ok {
 			return false
 		}
-		if x.MapStringNestedEnum[k] != y.MapStringNestedEnum[k] {
+		if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
 			return false
 		}
 	}
-	if x.GetOneofUint32() != y.GetOneofUint32() {
-		return false
-	}
-	if !x.GetOneofNestedMessage().Equal(y.GetOneofNestedMessage()) {
-		return false
-	}
-	if x.GetOneofString() != y.GetOneofString() {
-		return false
-	}
-	if string(x.GetOneofBytes()) != string(y.GetOneofBytes()) {
-		return false
-	}
-	if x.GetOneofBool() != y.GetOneofBool() {
-		return false
-	}
-	if x.GetOneofUint64() != y.GetOneofUint64() {
-		return false
-	}
-	if (math.IsNaN(float64(x.GetOneofFloat())) && !math.IsNaN(float64(y.GetOneofFloat())) || !math.IsNaN(float64(x.GetOneofFloat())) && math.IsNaN(float64(y.GetOneofFloat()))) || (!math.IsNaN(float64(x.GetOneofFloat())) && !math.IsNaN(float64(y.GetOneofFloat())) && x.GetOneofFloat() != y.GetOneofFloat()) {
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
 		return false
 	}
-	if (math.IsNaN(float64(x.GetOneofDouble())) && !math.IsNaN(float64(y.GetOneofDouble())) || !math.IsNaN(float64(x.GetOneofDouble())) && math.IsNaN(float64(y.GetOneofDouble()))) || (!math.IsNaN(float64(x.GetOneofDouble())) && !math.IsNaN(float64(y.GetOneofDouble())) && x.GetOneofDouble() != y.GetOneofDouble()) {
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
-	if x.GetOneofEnum() != y.GetOneofEnum() {
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
 		return false
 	}
-	if p, q := x.GetOneofWrappersStringValue(), y.GetOneofWrappersStringValue(); (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
-	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
+	if m, ok := interface{}(x.FieldMask).(interface {
+		Equal(*fieldmaskpb.FieldMask) bool
+	}); (ok && !m.Equal(y.FieldMask)) || (!ok && !proto.Equal(x.FieldMask, y.FieldMask)) {
 		return false
 	}
-	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+	if !equal.StructEqual(x.Struct, y.Struct, equal.UnknownCanonical) {
 		return false
 	}
-	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
+	if !equal.ValueEqual(x.Value, y.Value, equal.UnknownCanonical) {
 		return false
 	}
-	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+	if !equal.ListValueEqual(x.ListValue, y.ListValue, equal.UnknownCanonical) {
 		return false
 	}
 	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
@@ -578,10 +396,10 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
 		return false
 	}
-	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
 		return false
 	}
-	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
 		return false
 	}
 	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
@@ -602,11 +420,12 @@ func (x *TestAllTypes) Equal(y *TestAllTypes) bool {
 	if x.Enums3 != y.Enums3 {
 		return false
 	}
-	if equal, ok := interface{}(x.OtherMessage).(interface {
+	if m, ok := interface{}(x.OtherMessage).(interface {
 		Equal(*other.OtherMessage) bool
-	}); !ok || !equal.Equal(y.OtherMessage) {
+	}); (ok && !m.Equal(y.OtherMessage)) || (!ok && !proto.Equal(x.OtherMessage, y.OtherMessage)) {
 		return false
-	} else if !proto.Equal(x.OtherMessage, y.OtherMessage) {
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
 		return false
 	}
 	return true
@@ -625,5 +444,2813 @@ func (x *ForeignMessage) Equal(y *ForeignMessage) bool {
 	if x.D != y.D {
 		return false
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
+
+func init() {
+	equal.Register("goproto.proto.test3.TestAllTypes", (*TestAllTypes).Equal)
+	equal.Register("goproto.proto.test3.TestAllTypes.NestedMessage", (*TestAllTypes_NestedMessage).Equal)
+	equal.Register("goproto.proto.test3.ForeignMessage", (*ForeignMessage).Equal)
+}
+
+func (x *TestAllTypes_NestedMessage) Diff(y *TestAllTypes_NestedMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.OptionalEqual(x.A, y.A) {
+		d = append(d, equal.Difference{Path: "a", X: x.A, Y: y.A})
+	}
+	d = equal.AppendNested(d, "corecursive", x.Corecursive.Diff(y.Corecursive))
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes) Diff(y *TestAllTypes) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if x.SingularInt32 != y.SingularInt32 {
+		d = append(d, equal.Difference{Path: "singular_int32", X: x.SingularInt32, Y: y.SingularInt32})
+	}
+	if x.SingularInt64 != y.SingularInt64 {
+		d = append(d, equal.Difference{Path: "singular_int64", X: x.SingularInt64, Y: y.SingularInt64})
+	}
+	if x.SingularUint32 != y.SingularUint32 {
+		d = append(d, equal.Difference{Path: "singular_uint32", X: x.SingularUint32, Y: y.SingularUint32})
+	}
+	if x.SingularUint64 != y.SingularUint64 {
+		d = append(d, equal.Difference{Path: "singular_uint64", X: x.SingularUint64, Y: y.SingularUint64})
+	}
+	if x.SingularSint32 != y.SingularSint32 {
+		d = append(d, equal.Difference{Path: "singular_sint32", X: x.SingularSint32, Y: y.SingularSint32})
+	}
+	if x.SingularSint64 != y.SingularSint64 {
+		d = append(d, equal.Difference{Path: "singular_sint64", X: x.SingularSint64, Y: y.SingularSint64})
+	}
+	if x.SingularFixed32 != y.SingularFixed32 {
+		d = append(d, equal.Difference{Path: "singular_fixed32", X: x.SingularFixed32, Y: y.SingularFixed32})
+	}
+	if x.SingularFixed64 != y.SingularFixed64 {
+		d = append(d, equal.Difference{Path: "singular_fixed64", X: x.SingularFixed64, Y: y.SingularFixed64})
+	}
+	if x.SingularSfixed32 != y.SingularSfixed32 {
+		d = append(d, equal.Difference{Path: "singular_sfixed32", X: x.SingularSfixed32, Y: y.SingularSfixed32})
+	}
+	if x.SingularSfixed64 != y.SingularSfixed64 {
+		d = append(d, equal.Difference{Path: "singular_sfixed64", X: x.SingularSfixed64, Y: y.SingularSfixed64})
+	}
+	if !equal.FloatEqual(x.SingularFloat, y.SingularFloat) {
+		d = append(d, equal.Difference{Path: "singular_float", X: x.SingularFloat, Y: y.SingularFloat})
+	}
+	if !equal.FloatEqual(x.SingularDouble, y.SingularDouble) {
+		d = append(d, equal.Difference{Path: "singular_double", X: x.SingularDouble, Y: y.SingularDouble})
+	}
+	if x.SingularBool != y.SingularBool {
+		d = append(d, equal.Difference{Path: "singular_bool", X: x.SingularBool, Y: y.SingularBool})
+	}
+	if x.SingularString != y.SingularString {
+		d = append(d, equal.Difference{Path: "singular_string", X: x.SingularString, Y: y.SingularString})
+	}
+	if string(x.SingularBytes) != string(y.SingularBytes) {
+		d = append(d, equal.Difference{Path: "singular_bytes", X: x.SingularBytes, Y: y.SingularBytes})
+	}
+	d = equal.AppendNested(d, "singular_nested_message", x.SingularNestedMessage.Diff(y.SingularNestedMessage))
+	d = equal.AppendNested(d, "singular_foreign_message", x.SingularForeignMessage.Diff(y.SingularForeignMessage))
+	d = equal.AppendNested(d, "singular_import_message", x.SingularImportMessage.Diff(y.SingularImportMessage))
+	if x.SingularNestedEnum != y.SingularNestedEnum {
+		d = append(d, equal.Difference{Path: "singular_nested_enum", X: x.SingularNestedEnum, Y: y.SingularNestedEnum})
+	}
+	if x.SingularForeignEnum != y.SingularForeignEnum {
+		d = append(d, equal.Difference{Path: "singular_foreign_enum", X: x.SingularForeignEnum, Y: y.SingularForeignEnum})
+	}
+	if x.SingularImportEnum != y.SingularImportEnum {
+		d = append(d, equal.Difference{Path: "singular_import_enum", X: x.SingularImportEnum, Y: y.SingularImportEnum})
+	}
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
+		d = append(d, equal.Difference{Path: "optional_int32", X: x.OptionalInt32, Y: y.OptionalInt32})
+	}
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
+		d = append(d, equal.Difference{Path: "optional_int64", X: x.OptionalInt64, Y: y.OptionalInt64})
+	}
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
+		d = append(d, equal.Difference{Path: "optional_uint32", X: x.OptionalUint32, Y: y.OptionalUint32})
+	}
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
+		d = append(d, equal.Difference{Path: "optional_uint64", X: x.OptionalUint64, Y: y.OptionalUint64})
+	}
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
+		d = append(d, equal.Difference{Path: "optional_sint32", X: x.OptionalSint32, Y: y.OptionalSint32})
+	}
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
+		d = append(d, equal.Difference{Path: "optional_sint64", X: x.OptionalSint64, Y: y.OptionalSint64})
+	}
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
+		d = append(d, equal.Difference{Path: "optional_fixed32", X: x.OptionalFixed32, Y: y.OptionalFixed32})
+	}
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
+		d = append(d, equal.Difference{Path: "optional_fixed64", X: x.OptionalFixed64, Y: y.OptionalFixed64})
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
+		d = append(d, equal.Difference{Path: "optional_sfixed32", X: x.OptionalSfixed32, Y: y.OptionalSfixed32})
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
+		d = append(d, equal.Difference{Path: "optional_sfixed64", X: x.OptionalSfixed64, Y: y.OptionalSfixed64})
+	}
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
+		d = append(d, equal.Difference{Path: "optional_float", X: x.OptionalFloat, Y: y.OptionalFloat})
+	}
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
+		d = append(d, equal.Difference{Path: "optional_double", X: x.OptionalDouble, Y: y.OptionalDouble})
+	}
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
+		d = append(d, equal.Difference{Path: "optional_bool", X: x.OptionalBool, Y: y.OptionalBool})
+	}
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
+		d = append(d, equal.Difference{Path: "optional_string", X: x.OptionalString, Y: y.OptionalString})
+	}
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
+		d = append(d, equal.Difference{Path: "optional_bytes", X: x.OptionalBytes, Y: y.OptionalBytes})
+	}
+	d = equal.AppendNested(d, "optional_nested_message", x.OptionalNestedMessage.Diff(y.OptionalNestedMessage))
+	d = equal.AppendNested(d, "optional_foreign_message", x.OptionalForeignMessage.Diff(y.OptionalForeignMessage))
+	d = equal.AppendNested(d, "optional_import_message", x.OptionalImportMessage.Diff(y.OptionalImportMessage))
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
+		d = append(d, equal.Difference{Path: "optional_nested_enum", X: x.OptionalNestedEnum, Y: y.OptionalNestedEnum})
+	}
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
+		d = append(d, equal.Difference{Path: "optional_foreign_enum", X: x.OptionalForeignEnum, Y: y.OptionalForeignEnum})
+	}
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
+		d = append(d, equal.Difference{Path: "optional_import_enum", X: x.OptionalImportEnum, Y: y.OptionalImportEnum})
+	}
+	if len(x.RepeatedInt32) != len(y.RepeatedInt32) {
+		d = append(d, equal.Difference{Path: "repeated_int32", X: x.RepeatedInt32, Y: y.RepeatedInt32})
+	} else {
+		for i := 0; i < len(x.RepeatedInt32); i++ {
+			if x.RepeatedInt32[i] != y.RepeatedInt32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_int32", i), X: x.RepeatedInt32[i], Y: y.RepeatedInt32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedInt64) != len(y.RepeatedInt64) {
+		d = append(d, equal.Difference{Path: "repeated_int64", X: x.RepeatedInt64, Y: y.RepeatedInt64})
+	} else {
+		for i := 0; i < len(x.RepeatedInt64); i++ {
+			if x.RepeatedInt64[i] != y.RepeatedInt64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_int64", i), X: x.RepeatedInt64[i], Y: y.RepeatedInt64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedUint32) != len(y.RepeatedUint32) {
+		d = append(d, equal.Difference{Path: "repeated_uint32", X: x.RepeatedUint32, Y: y.RepeatedUint32})
+	} else {
+		for i := 0; i < len(x.RepeatedUint32); i++ {
+			if x.RepeatedUint32[i] != y.RepeatedUint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_uint32", i), X: x.RepeatedUint32[i], Y: y.RepeatedUint32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedUint64) != len(y.RepeatedUint64) {
+		d = append(d, equal.Difference{Path: "repeated_uint64", X: x.RepeatedUint64, Y: y.RepeatedUint64})
+	} else {
+		for i := 0; i < len(x.RepeatedUint64); i++ {
+			if x.RepeatedUint64[i] != y.RepeatedUint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_uint64", i), X: x.RepeatedUint64[i], Y: y.RepeatedUint64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSint32) != len(y.RepeatedSint32) {
+		d = append(d, equal.Difference{Path: "repeated_sint32", X: x.RepeatedSint32, Y: y.RepeatedSint32})
+	} else {
+		for i := 0; i < len(x.RepeatedSint32); i++ {
+			if x.RepeatedSint32[i] != y.RepeatedSint32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sint32", i), X: x.RepeatedSint32[i], Y: y.RepeatedSint32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSint64) != len(y.RepeatedSint64) {
+		d = append(d, equal.Difference{Path: "repeated_sint64", X: x.RepeatedSint64, Y: y.RepeatedSint64})
+	} else {
+		for i := 0; i < len(x.RepeatedSint64); i++ {
+			if x.RepeatedSint64[i] != y.RepeatedSint64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sint64", i), X: x.RepeatedSint64[i], Y: y.RepeatedSint64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFixed32) != len(y.RepeatedFixed32) {
+		d = append(d, equal.Difference{Path: "repeated_fixed32", X: x.RepeatedFixed32, Y: y.RepeatedFixed32})
+	} else {
+		for i := 0; i < len(x.RepeatedFixed32); i++ {
+			if x.RepeatedFixed32[i] != y.RepeatedFixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_fixed32", i), X: x.RepeatedFixed32[i], Y: y.RepeatedFixed32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFixed64) != len(y.RepeatedFixed64) {
+		d = append(d, equal.Difference{Path: "repeated_fixed64", X: x.RepeatedFixed64, Y: y.RepeatedFixed64})
+	} else {
+		for i := 0; i < len(x.RepeatedFixed64); i++ {
+			if x.RepeatedFixed64[i] != y.RepeatedFixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_fixed64", i), X: x.RepeatedFixed64[i], Y: y.RepeatedFixed64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSfixed32) != len(y.RepeatedSfixed32) {
+		d = append(d, equal.Difference{Path: "repeated_sfixed32", X: x.RepeatedSfixed32, Y: y.RepeatedSfixed32})
+	} else {
+		for i := 0; i < len(x.RepeatedSfixed32); i++ {
+			if x.RepeatedSfixed32[i] != y.RepeatedSfixed32[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sfixed32", i), X: x.RepeatedSfixed32[i], Y: y.RepeatedSfixed32[i]})
+			}
+		}
+	}
+	if len(x.RepeatedSfixed64) != len(y.RepeatedSfixed64) {
+		d = append(d, equal.Difference{Path: "repeated_sfixed64", X: x.RepeatedSfixed64, Y: y.RepeatedSfixed64})
+	} else {
+		for i := 0; i < len(x.RepeatedSfixed64); i++ {
+			if x.RepeatedSfixed64[i] != y.RepeatedSfixed64[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_sfixed64", i), X: x.RepeatedSfixed64[i], Y: y.RepeatedSfixed64[i]})
+			}
+		}
+	}
+	if len(x.RepeatedFloat) != len(y.RepeatedFloat) {
+		d = append(d, equal.Difference{Path: "repeated_float", X: x.RepeatedFloat, Y: y.RepeatedFloat})
+	} else {
+		for i := 0; i < len(x.RepeatedFloat); i++ {
+			if !equal.FloatEqual(x.RepeatedFloat[i], y.RepeatedFloat[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_float", i), X: x.RepeatedFloat[i], Y: y.RepeatedFloat[i]})
+			}
+		}
+	}
+	if len(x.RepeatedDouble) != len(y.RepeatedDouble) {
+		d = append(d, equal.Difference{Path: "repeated_double", X: x.RepeatedDouble, Y: y.RepeatedDouble})
+	} else {
+		for i := 0; i < len(x.RepeatedDouble); i++ {
+			if !equal.FloatEqual(x.RepeatedDouble[i], y.RepeatedDouble[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_double", i), X: x.RepeatedDouble[i], Y: y.RepeatedDouble[i]})
+			}
+		}
+	}
+	if len(x.RepeatedBool) != len(y.RepeatedBool) {
+		d = append(d, equal.Difference{Path: "repeated_bool", X: x.RepeatedBool, Y: y.RepeatedBool})
+	} else {
+		for i := 0; i < len(x.RepeatedBool); i++ {
+			if x.RepeatedBool[i] != y.RepeatedBool[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_bool", i), X: x.RepeatedBool[i], Y: y.RepeatedBool[i]})
+			}
+		}
+	}
+	if len(x.RepeatedString) != len(y.RepeatedString) {
+		d = append(d, equal.Difference{Path: "repeated_string", X: x.RepeatedString, Y: y.RepeatedString})
+	} else {
+		for i := 0; i < len(x.RepeatedString); i++ {
+			if x.RepeatedString[i] != y.RepeatedString[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_string", i), X: x.RepeatedString[i], Y: y.RepeatedString[i]})
+			}
+		}
+	}
+	if len(x.RepeatedBytes) != len(y.RepeatedBytes) {
+		d = append(d, equal.Difference{Path: "repeated_bytes", X: x.RepeatedBytes, Y: y.RepeatedBytes})
+	} else {
+		for i := 0; i < len(x.RepeatedBytes); i++ {
+			if string(x.RepeatedBytes[i]) != string(y.RepeatedBytes[i]) {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_bytes", i), X: x.RepeatedBytes[i], Y: y.RepeatedBytes[i]})
+			}
+		}
+	}
+	if len(x.RepeatedNestedMessage) != len(y.RepeatedNestedMessage) {
+		d = append(d, equal.Difference{Path: "repeated_nested_message", X: x.RepeatedNestedMessage, Y: y.RepeatedNestedMessage})
+	} else {
+		for i := 0; i < len(x.RepeatedNestedMessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_nested_message", i), x.RepeatedNestedMessage[i].Diff(y.RepeatedNestedMessage[i]))
+		}
+	}
+	if len(x.RepeatedForeignMessage) != len(y.RepeatedForeignMessage) {
+		d = append(d, equal.Difference{Path: "repeated_foreign_message", X: x.RepeatedForeignMessage, Y: y.RepeatedForeignMessage})
+	} else {
+		for i := 0; i < len(x.RepeatedForeignMessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_foreign_message", i), x.RepeatedForeignMessage[i].Diff(y.RepeatedForeignMessage[i]))
+		}
+	}
+	if len(x.RepeatedImportmessage) != len(y.RepeatedImportmessage) {
+		d = append(d, equal.Difference{Path: "repeated_importmessage", X: x.RepeatedImportmessage, Y: y.RepeatedImportmessage})
+	} else {
+		for i := 0; i < len(x.RepeatedImportmessage); i++ {
+			d = equal.AppendNested(d, equal.Index("repeated_importmessage", i), x.RepeatedImportmessage[i].Diff(y.RepeatedImportmessage[i]))
+		}
+	}
+	if len(x.RepeatedNestedEnum) != len(y.RepeatedNestedEnum) {
+		d = append(d, equal.Difference{Path: "repeated_nested_enum", X: x.RepeatedNestedEnum, Y: y.RepeatedNestedEnum})
+	} else {
+		for i := 0; i < len(x.RepeatedNestedEnum); i++ {
+			if x.RepeatedNestedEnum[i] != y.RepeatedNestedEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_nested_enum", i), X: x.RepeatedNestedEnum[i], Y: y.RepeatedNestedEnum[i]})
+			}
+		}
+	}
+	if len(x.RepeatedForeignEnum) != len(y.RepeatedForeignEnum) {
+		d = append(d, equal.Difference{Path: "repeated_foreign_enum", X: x.RepeatedForeignEnum, Y: y.RepeatedForeignEnum})
+	} else {
+		for i := 0; i < len(x.RepeatedForeignEnum); i++ {
+			if x.RepeatedForeignEnum[i] != y.RepeatedForeignEnum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_foreign_enum", i), X: x.RepeatedForeignEnum[i], Y: y.RepeatedForeignEnum[i]})
+			}
+		}
+	}
+	if len(x.RepeatedImportenum) != len(y.RepeatedImportenum) {
+		d = append(d, equal.Difference{Path: "repeated_importenum", X: x.RepeatedImportenum, Y: y.RepeatedImportenum})
+	} else {
+		for i := 0; i < len(x.RepeatedImportenum); i++ {
+			if x.RepeatedImportenum[i] != y.RepeatedImportenum[i] {
+				d = append(d, equal.Difference{Path: equal.Index("repeated_importenum", i), X: x.RepeatedImportenum[i], Y: y.RepeatedImportenum[i]})
+			}
+		}
+	}
+	for k := range x.MapInt32Int32 {
+		if _, ok := y.MapInt32Int32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), X: x.MapInt32Int32[k]})
+			continue
+		}
+		if x.MapInt32Int32[k] != y.MapInt32Int32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), X: x.MapInt32Int32[k], Y: y.MapInt32Int32[k]})
+		}
+	}
+	for k := range y.MapInt32Int32 {
+		if _, ok := x.MapInt32Int32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_int32", k), Y: y.MapInt32Int32[k]})
+		}
+	}
+	for k := range x.MapInt64Int64 {
+		if _, ok := y.MapInt64Int64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), X: x.MapInt64Int64[k]})
+			continue
+		}
+		if x.MapInt64Int64[k] != y.MapInt64Int64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), X: x.MapInt64Int64[k], Y: y.MapInt64Int64[k]})
+		}
+	}
+	for k := range y.MapInt64Int64 {
+		if _, ok := x.MapInt64Int64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int64_int64", k), Y: y.MapInt64Int64[k]})
+		}
+	}
+	for k := range x.MapUint32Uint32 {
+		if _, ok := y.MapUint32Uint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), X: x.MapUint32Uint32[k]})
+			continue
+		}
+		if x.MapUint32Uint32[k] != y.MapUint32Uint32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), X: x.MapUint32Uint32[k], Y: y.MapUint32Uint32[k]})
+		}
+	}
+	for k := range y.MapUint32Uint32 {
+		if _, ok := x.MapUint32Uint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint32_uint32", k), Y: y.MapUint32Uint32[k]})
+		}
+	}
+	for k := range x.MapUint64Uint64 {
+		if _, ok := y.MapUint64Uint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), X: x.MapUint64Uint64[k]})
+			continue
+		}
+		if x.MapUint64Uint64[k] != y.MapUint64Uint64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), X: x.MapUint64Uint64[k], Y: y.MapUint64Uint64[k]})
+		}
+	}
+	for k := range y.MapUint64Uint64 {
+		if _, ok := x.MapUint64Uint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_uint64_uint64", k), Y: y.MapUint64Uint64[k]})
+		}
+	}
+	for k := range x.MapSint32Sint32 {
+		if _, ok := y.MapSint32Sint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), X: x.MapSint32Sint32[k]})
+			continue
+		}
+		if x.MapSint32Sint32[k] != y.MapSint32Sint32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), X: x.MapSint32Sint32[k], Y: y.MapSint32Sint32[k]})
+		}
+	}
+	for k := range y.MapSint32Sint32 {
+		if _, ok := x.MapSint32Sint32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint32_sint32", k), Y: y.MapSint32Sint32[k]})
+		}
+	}
+	for k := range x.MapSint64Sint64 {
+		if _, ok := y.MapSint64Sint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), X: x.MapSint64Sint64[k]})
+			continue
+		}
+		if x.MapSint64Sint64[k] != y.MapSint64Sint64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), X: x.MapSint64Sint64[k], Y: y.MapSint64Sint64[k]})
+		}
+	}
+	for k := range y.MapSint64Sint64 {
+		if _, ok := x.MapSint64Sint64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sint64_sint64", k), Y: y.MapSint64Sint64[k]})
+		}
+	}
+	for k := range x.MapFixed32Fixed32 {
+		if _, ok := y.MapFixed32Fixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), X: x.MapFixed32Fixed32[k]})
+			continue
+		}
+		if x.MapFixed32Fixed32[k] != y.MapFixed32Fixed32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), X: x.MapFixed32Fixed32[k], Y: y.MapFixed32Fixed32[k]})
+		}
+	}
+	for k := range y.MapFixed32Fixed32 {
+		if _, ok := x.MapFixed32Fixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed32_fixed32", k), Y: y.MapFixed32Fixed32[k]})
+		}
+	}
+	for k := range x.MapFixed64Fixed64 {
+		if _, ok := y.MapFixed64Fixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), X: x.MapFixed64Fixed64[k]})
+			continue
+		}
+		if x.MapFixed64Fixed64[k] != y.MapFixed64Fixed64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), X: x.MapFixed64Fixed64[k], Y: y.MapFixed64Fixed64[k]})
+		}
+	}
+	for k := range y.MapFixed64Fixed64 {
+		if _, ok := x.MapFixed64Fixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_fixed64_fixed64", k), Y: y.MapFixed64Fixed64[k]})
+		}
+	}
+	for k := range x.MapSfixed32Sfixed32 {
+		if _, ok := y.MapSfixed32Sfixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), X: x.MapSfixed32Sfixed32[k]})
+			continue
+		}
+		if x.MapSfixed32Sfixed32[k] != y.MapSfixed32Sfixed32[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), X: x.MapSfixed32Sfixed32[k], Y: y.MapSfixed32Sfixed32[k]})
+		}
+	}
+	for k := range y.MapSfixed32Sfixed32 {
+		if _, ok := x.MapSfixed32Sfixed32[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed32_sfixed32", k), Y: y.MapSfixed32Sfixed32[k]})
+		}
+	}
+	for k := range x.MapSfixed64Sfixed64 {
+		if _, ok := y.MapSfixed64Sfixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), X: x.MapSfixed64Sfixed64[k]})
+			continue
+		}
+		if x.MapSfixed64Sfixed64[k] != y.MapSfixed64Sfixed64[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), X: x.MapSfixed64Sfixed64[k], Y: y.MapSfixed64Sfixed64[k]})
+		}
+	}
+	for k := range y.MapSfixed64Sfixed64 {
+		if _, ok := x.MapSfixed64Sfixed64[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_sfixed64_sfixed64", k), Y: y.MapSfixed64Sfixed64[k]})
+		}
+	}
+	for k := range x.MapInt32Float {
+		if _, ok := y.MapInt32Float[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), X: x.MapInt32Float[k]})
+			continue
+		}
+		if !equal.FloatEqual(x.MapInt32Float[k], y.MapInt32Float[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), X: x.MapInt32Float[k], Y: y.MapInt32Float[k]})
+		}
+	}
+	for k := range y.MapInt32Float {
+		if _, ok := x.MapInt32Float[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_float", k), Y: y.MapInt32Float[k]})
+		}
+	}
+	for k := range x.MapInt32Double {
+		if _, ok := y.MapInt32Double[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), X: x.MapInt32Double[k]})
+			continue
+		}
+		if !equal.FloatEqual(x.MapInt32Double[k], y.MapInt32Double[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), X: x.MapInt32Double[k], Y: y.MapInt32Double[k]})
+		}
+	}
+	for k := range y.MapInt32Double {
+		if _, ok := x.MapInt32Double[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_int32_double", k), Y: y.MapInt32Double[k]})
+		}
+	}
+	for k := range x.MapBoolBool {
+		if _, ok := y.MapBoolBool[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), X: x.MapBoolBool[k]})
+			continue
+		}
+		if x.MapBoolBool[k] != y.MapBoolBool[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), X: x.MapBoolBool[k], Y: y.MapBoolBool[k]})
+		}
+	}
+	for k := range y.MapBoolBool {
+		if _, ok := x.MapBoolBool[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_bool_bool", k), Y: y.MapBoolBool[k]})
+		}
+	}
+	for k := range x.MapStringString {
+		if _, ok := y.MapStringString[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), X: x.MapStringString[k]})
+			continue
+		}
+		if x.MapStringString[k] != y.MapStringString[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), X: x.MapStringString[k], Y: y.MapStringString[k]})
+		}
+	}
+	for k := range y.MapStringString {
+		if _, ok := x.MapStringString[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_string", k), Y: y.MapStringString[k]})
+		}
+	}
+	for k := range x.MapStringBytes {
+		if _, ok := y.MapStringBytes[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), X: x.MapStringBytes[k]})
+			continue
+		}
+		if string(x.MapStringBytes[k]) != string(y.MapStringBytes[k]) {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), X: x.MapStringBytes[k], Y: y.MapStringBytes[k]})
+		}
+	}
+	for k := range y.MapStringBytes {
+		if _, ok := x.MapStringBytes[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_bytes", k), Y: y.MapStringBytes[k]})
+		}
+	}
+	for k := range x.MapStringNestedMessage {
+		if _, ok := y.MapStringNestedMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_message", k), X: x.MapStringNestedMessage[k]})
+			continue
+		}
+		d = equal.AppendNested(d, equal.Key("map_string_nested_message", k), x.MapStringNestedMessage[k].Diff(y.MapStringNestedMessage[k]))
+	}
+	for k := range y.MapStringNestedMessage {
+		if _, ok := x.MapStringNestedMessage[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_message", k), Y: y.MapStringNestedMessage[k]})
+		}
+	}
+	for k := range x.MapStringNestedEnum {
+		if _, ok := y.MapStringNestedEnum[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), X: x.MapStringNestedEnum[k]})
+			continue
+		}
+		if x.MapStringNestedEnum[k] != y.MapStringNestedEnum[k] {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), X: x.MapStringNestedEnum[k], Y: y.MapStringNestedEnum[k]})
+		}
+	}
+	for k := range y.MapStringNestedEnum {
+		if _, ok := x.MapStringNestedEnum[k]; !ok {
+			d = append(d, equal.Difference{Path: equal.Key("map_string_nested_enum", k), Y: y.MapStringNestedEnum[k]})
+		}
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			d = append(d, equal.Difference{Path: "oneof_field", Y: y.OneofField})
+		}
+	case *TestAllTypes_OneofUint32:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofUint32 != yv.OneofUint32 {
+				d = append(d, equal.Difference{Path: "oneof_uint32", X: xv.OneofUint32, Y: yv.OneofUint32})
+			}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			d = equal.AppendNested(d, "oneof_nested_message", xv.OneofNestedMessage.Diff(yv.OneofNestedMessage))
+		}
+	case *TestAllTypes_OneofString:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofString); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofString != yv.OneofString {
+				d = append(d, equal.Difference{Path: "oneof_string", X: xv.OneofString, Y: yv.OneofString})
+			}
+		}
+	case *TestAllTypes_OneofBytes:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if string(xv.OneofBytes) != string(yv.OneofBytes) {
+				d = append(d, equal.Difference{Path: "oneof_bytes", X: xv.OneofBytes, Y: yv.OneofBytes})
+			}
+		}
+	case *TestAllTypes_OneofBool:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofBool != yv.OneofBool {
+				d = append(d, equal.Difference{Path: "oneof_bool", X: xv.OneofBool, Y: yv.OneofBool})
+			}
+		}
+	case *TestAllTypes_OneofUint64:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofUint64 != yv.OneofUint64 {
+				d = append(d, equal.Difference{Path: "oneof_uint64", X: xv.OneofUint64, Y: yv.OneofUint64})
+			}
+		}
+	case *TestAllTypes_OneofFloat:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
+				d = append(d, equal.Difference{Path: "oneof_float", X: xv.OneofFloat, Y: yv.OneofFloat})
+			}
+		}
+	case *TestAllTypes_OneofDouble:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
+				d = append(d, equal.Difference{Path: "oneof_double", X: xv.OneofDouble, Y: yv.OneofDouble})
+			}
+		}
+	case *TestAllTypes_OneofEnum:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if xv.OneofEnum != yv.OneofEnum {
+				d = append(d, equal.Difference{Path: "oneof_enum", X: xv.OneofEnum, Y: yv.OneofEnum})
+			}
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			d = append(d, equal.Difference{Path: "oneof_field", X: x.OneofField, Y: y.OneofField})
+		} else {
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+				d = append(d, equal.Difference{Path: "oneof_wrappers_string_value", X: xv.OneofWrappersStringValue, Y: yv.OneofWrappersStringValue})
+			}
+		}
+	}
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
+		d = append(d, equal.Difference{Path: "any", X: x.Any, Y: y.Any})
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		d = append(d, equal.Difference{Path: "duration", X: x.Duration, Y: y.Duration})
+	}
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
+		d = append(d, equal.Difference{Path: "empty", X: x.Empty, Y: y.Empty})
+	}
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		d = append(d, equal.Difference{Path: "timestamp", X: x.Timestamp, Y: y.Timestamp})
+	}
+	if m, ok := interface{}(x.FieldMask).(interface {
+		Diff(*fieldmaskpb.FieldMask) []equal.Difference
+	}); ok {
+		d = equal.AppendNested(d, "field_mask", m.Diff(y.FieldMask))
+	} else if !proto.Equal(x.FieldMask, y.FieldMask) {
+		d = append(d, equal.Difference{Path: "field_mask", X: x.FieldMask, Y: y.FieldMask})
+	}
+	if !equal.StructEqual(x.Struct, y.Struct, equal.UnknownCanonical) {
+		d = append(d, equal.Difference{Path: "struct", X: x.Struct, Y: y.Struct})
+	}
+	if !equal.ValueEqual(x.Value, y.Value, equal.UnknownCanonical) {
+		d = append(d, equal.Difference{Path: "value", X: x.Value, Y: y.Value})
+	}
+	if !equal.ListValueEqual(x.ListValue, y.ListValue, equal.UnknownCanonical) {
+		d = append(d, equal.Difference{Path: "list_value", X: x.ListValue, Y: y.ListValue})
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_bool_value", X: x.WrappersBoolValue, Y: y.WrappersBoolValue})
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_bytes_value", X: x.WrappersBytesValue, Y: y.WrappersBytesValue})
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_double_value", X: x.WrappersDoubleValue, Y: y.WrappersDoubleValue})
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		d = append(d, equal.Difference{Path: "wrappers_float_value", X: x.WrappersFloatValue, Y: y.WrappersFloatValue})
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_int32_value", X: x.WrappersInt32Value, Y: y.WrappersInt32Value})
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_int64_value", X: x.WrappersInt64Value, Y: y.WrappersInt64Value})
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_string_value", X: x.WrappersStringValue, Y: y.WrappersStringValue})
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_uint32_value", X: x.WrappersUint32Value, Y: y.WrappersUint32Value})
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		d = append(d, equal.Difference{Path: "wrappers_uint64_value", X: x.WrappersUint64Value, Y: y.WrappersUint64Value})
+	}
+	if x.Enums3 != y.Enums3 {
+		d = append(d, equal.Difference{Path: "enums3", X: x.Enums3, Y: y.Enums3})
+	}
+	if m, ok := interface{}(x.OtherMessage).(interface {
+		Diff(*other.OtherMessage) []equal.Difference
+	}); ok {
+		d = equal.AppendNested(d, "other_message", m.Diff(y.OtherMessage))
+	} else if !proto.Equal(x.OtherMessage, y.OtherMessage) {
+		d = append(d, equal.Difference{Path: "other_message", X: x.OtherMessage, Y: y.OtherMessage})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *ForeignMessage) Diff(y *ForeignMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if x.C != y.C {
+		d = append(d, equal.Difference{Path: "c", X: x.C, Y: y.C})
+	}
+	if x.D != y.D {
+		d = append(d, equal.Difference{Path: "d", X: x.D, Y: y.D})
+	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *TestAllTypes_NestedMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashBool(h, x.A != nil)
+	if p := x.A; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.Corecursive != nil)
+	x.Corecursive.Hash(h)
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(x.SingularInt32))
+	equal.HashUint64(h, uint64(x.SingularInt64))
+	equal.HashUint64(h, uint64(x.SingularUint32))
+	equal.HashUint64(h, uint64(x.SingularUint64))
+	equal.HashUint64(h, uint64(x.SingularSint32))
+	equal.HashUint64(h, uint64(x.SingularSint64))
+	equal.HashUint64(h, uint64(x.SingularFixed32))
+	equal.HashUint64(h, uint64(x.SingularFixed64))
+	equal.HashUint64(h, uint64(x.SingularSfixed32))
+	equal.HashUint64(h, uint64(x.SingularSfixed64))
+	equal.HashFloat64(h, float64(x.SingularFloat))
+	equal.HashFloat64(h, float64(x.SingularDouble))
+	equal.HashBool(h, x.SingularBool)
+	equal.HashString(h, x.SingularString)
+	equal.HashBytes(h, x.SingularBytes)
+	equal.HashBool(h, x.SingularNestedMessage != nil)
+	x.SingularNestedMessage.Hash(h)
+	equal.HashBool(h, x.SingularForeignMessage != nil)
+	x.SingularForeignMessage.Hash(h)
+	equal.HashBool(h, x.SingularImportMessage != nil)
+	x.SingularImportMessage.Hash(h)
+	equal.HashUint64(h, uint64(x.SingularNestedEnum))
+	equal.HashUint64(h, uint64(x.SingularForeignEnum))
+	equal.HashUint64(h, uint64(x.SingularImportEnum))
+	equal.HashBool(h, x.OptionalInt32 != nil)
+	if p := x.OptionalInt32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalInt64 != nil)
+	if p := x.OptionalInt64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalUint32 != nil)
+	if p := x.OptionalUint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalUint64 != nil)
+	if p := x.OptionalUint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSint32 != nil)
+	if p := x.OptionalSint32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSint64 != nil)
+	if p := x.OptionalSint64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFixed32 != nil)
+	if p := x.OptionalFixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFixed64 != nil)
+	if p := x.OptionalFixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSfixed32 != nil)
+	if p := x.OptionalSfixed32; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalSfixed64 != nil)
+	if p := x.OptionalSfixed64; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalFloat != nil)
+	if p := x.OptionalFloat; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.OptionalDouble != nil)
+	if p := x.OptionalDouble; p != nil {
+		equal.HashFloat64(h, float64(*p))
+	}
+	equal.HashBool(h, x.OptionalBool != nil)
+	if p := x.OptionalBool; p != nil {
+		equal.HashBool(h, *p)
+	}
+	equal.HashBool(h, x.OptionalString != nil)
+	if p := x.OptionalString; p != nil {
+		equal.HashString(h, *p)
+	}
+	equal.HashBool(h, x.OptionalBytes != nil)
+	equal.HashBytes(h, x.OptionalBytes)
+	equal.HashBool(h, x.OptionalNestedMessage != nil)
+	x.OptionalNestedMessage.Hash(h)
+	equal.HashBool(h, x.OptionalForeignMessage != nil)
+	x.OptionalForeignMessage.Hash(h)
+	equal.HashBool(h, x.OptionalImportMessage != nil)
+	x.OptionalImportMessage.Hash(h)
+	equal.HashBool(h, x.OptionalNestedEnum != nil)
+	if p := x.OptionalNestedEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalForeignEnum != nil)
+	if p := x.OptionalForeignEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashBool(h, x.OptionalImportEnum != nil)
+	if p := x.OptionalImportEnum; p != nil {
+		equal.HashUint64(h, uint64(*p))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedInt32)))
+	for i := 0; i < len(x.RepeatedInt32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedInt32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedInt64)))
+	for i := 0; i < len(x.RepeatedInt64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedInt64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedUint32)))
+	for i := 0; i < len(x.RepeatedUint32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedUint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedUint64)))
+	for i := 0; i < len(x.RepeatedUint64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedUint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSint32)))
+	for i := 0; i < len(x.RepeatedSint32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSint32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSint64)))
+	for i := 0; i < len(x.RepeatedSint64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSint64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFixed32)))
+	for i := 0; i < len(x.RepeatedFixed32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedFixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFixed64)))
+	for i := 0; i < len(x.RepeatedFixed64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedFixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSfixed32)))
+	for i := 0; i < len(x.RepeatedSfixed32); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSfixed32[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedSfixed64)))
+	for i := 0; i < len(x.RepeatedSfixed64); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedSfixed64[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedFloat)))
+	for i := 0; i < len(x.RepeatedFloat); i++ {
+		equal.HashFloat64(h, float64(x.RepeatedFloat[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedDouble)))
+	for i := 0; i < len(x.RepeatedDouble); i++ {
+		equal.HashFloat64(h, float64(x.RepeatedDouble[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedBool)))
+	for i := 0; i < len(x.RepeatedBool); i++ {
+		equal.HashBool(h, x.RepeatedBool[i])
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedString)))
+	for i := 0; i < len(x.RepeatedString); i++ {
+		equal.HashString(h, x.RepeatedString[i])
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedBytes)))
+	for i := 0; i < len(x.RepeatedBytes); i++ {
+		equal.HashBytes(h, x.RepeatedBytes[i])
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedNestedMessage)))
+	for i := 0; i < len(x.RepeatedNestedMessage); i++ {
+		equal.HashBool(h, x.RepeatedNestedMessage[i] != nil)
+		x.RepeatedNestedMessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedForeignMessage)))
+	for i := 0; i < len(x.RepeatedForeignMessage); i++ {
+		equal.HashBool(h, x.RepeatedForeignMessage[i] != nil)
+		x.RepeatedForeignMessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedImportmessage)))
+	for i := 0; i < len(x.RepeatedImportmessage); i++ {
+		equal.HashBool(h, x.RepeatedImportmessage[i] != nil)
+		x.RepeatedImportmessage[i].Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedNestedEnum)))
+	for i := 0; i < len(x.RepeatedNestedEnum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedNestedEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedForeignEnum)))
+	for i := 0; i < len(x.RepeatedForeignEnum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedForeignEnum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.RepeatedImportenum)))
+	for i := 0; i < len(x.RepeatedImportenum); i++ {
+		equal.HashUint64(h, uint64(x.RepeatedImportenum[i]))
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Int32)))
+	if len(x.MapInt32Int32) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Int32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt64Int64)))
+	if len(x.MapInt64Int64) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt64Int64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapUint32Uint32)))
+	if len(x.MapUint32Uint32) > 0 {
+		var sum uint64
+		for k, v := range x.MapUint32Uint32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapUint64Uint64)))
+	if len(x.MapUint64Uint64) > 0 {
+		var sum uint64
+		for k, v := range x.MapUint64Uint64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSint32Sint32)))
+	if len(x.MapSint32Sint32) > 0 {
+		var sum uint64
+		for k, v := range x.MapSint32Sint32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSint64Sint64)))
+	if len(x.MapSint64Sint64) > 0 {
+		var sum uint64
+		for k, v := range x.MapSint64Sint64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapFixed32Fixed32)))
+	if len(x.MapFixed32Fixed32) > 0 {
+		var sum uint64
+		for k, v := range x.MapFixed32Fixed32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapFixed64Fixed64)))
+	if len(x.MapFixed64Fixed64) > 0 {
+		var sum uint64
+		for k, v := range x.MapFixed64Fixed64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSfixed32Sfixed32)))
+	if len(x.MapSfixed32Sfixed32) > 0 {
+		var sum uint64
+		for k, v := range x.MapSfixed32Sfixed32 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapSfixed64Sfixed64)))
+	if len(x.MapSfixed64Sfixed64) > 0 {
+		var sum uint64
+		for k, v := range x.MapSfixed64Sfixed64 {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Float)))
+	if len(x.MapInt32Float) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Float {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashFloat64(&e, float64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapInt32Double)))
+	if len(x.MapInt32Double) > 0 {
+		var sum uint64
+		for k, v := range x.MapInt32Double {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashUint64(&e, uint64(k))
+			equal.HashFloat64(&e, float64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapBoolBool)))
+	if len(x.MapBoolBool) > 0 {
+		var sum uint64
+		for k, v := range x.MapBoolBool {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashBool(&e, k)
+			equal.HashBool(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringString)))
+	if len(x.MapStringString) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringString {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashString(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringBytes)))
+	if len(x.MapStringBytes) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringBytes {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashBytes(&e, v)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringNestedMessage)))
+	if len(x.MapStringNestedMessage) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringNestedMessage {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashBool(&e, v != nil)
+			v.Hash(&e)
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	equal.HashUint64(h, uint64(len(x.MapStringNestedEnum)))
+	if len(x.MapStringNestedEnum) > 0 {
+		var sum uint64
+		for k, v := range x.MapStringNestedEnum {
+			var e maphash.Hash
+			e.SetSeed(h.Seed())
+			equal.HashString(&e, k)
+			equal.HashUint64(&e, uint64(v))
+			sum += e.Sum64()
+		}
+		equal.HashUint64(h, sum)
+	}
+	switch v := x.OneofField.(type) {
+	case nil:
+		equal.HashUint64(h, 0)
+	case *TestAllTypes_OneofUint32:
+		equal.HashUint64(h, 111)
+		equal.HashUint64(h, uint64(v.OneofUint32))
+	case *TestAllTypes_OneofNestedMessage:
+		equal.HashUint64(h, 112)
+		equal.HashBool(h, v.OneofNestedMessage != nil)
+		v.OneofNestedMessage.Hash(h)
+	case *TestAllTypes_OneofString:
+		equal.HashUint64(h, 113)
+		equal.HashString(h, v.OneofString)
+	case *TestAllTypes_OneofBytes:
+		equal.HashUint64(h, 114)
+		equal.HashBytes(h, v.OneofBytes)
+	case *TestAllTypes_OneofBool:
+		equal.HashUint64(h, 115)
+		equal.HashBool(h, v.OneofBool)
+	case *TestAllTypes_OneofUint64:
+		equal.HashUint64(h, 116)
+		equal.HashUint64(h, uint64(v.OneofUint64))
+	case *TestAllTypes_OneofFloat:
+		equal.HashUint64(h, 117)
+		equal.HashFloat64(h, float64(v.OneofFloat))
+	case *TestAllTypes_OneofDouble:
+		equal.HashUint64(h, 118)
+		equal.HashFloat64(h, float64(v.OneofDouble))
+	case *TestAllTypes_OneofEnum:
+		equal.HashUint64(h, 119)
+		equal.HashUint64(h, uint64(v.OneofEnum))
+	case *TestAllTypes_OneofWrappersStringValue:
+		equal.HashUint64(h, 120)
+		equal.HashBool(h, v.OneofWrappersStringValue != nil)
+		if p := v.OneofWrappersStringValue; p != nil {
+			equal.HashString(h, p.Value)
+		}
+	}
+	equal.HashBool(h, x.Any != nil)
+	if p := x.Any; p != nil {
+		equal.HashString(h, p.TypeUrl)
+		equal.HashBytes(h, p.Value)
+	}
+	equal.HashBool(h, x.Duration != nil)
+	if p := x.Duration; p != nil {
+		equal.HashUint64(h, uint64(p.Seconds))
+		equal.HashUint64(h, uint64(p.Nanos))
+	}
+	equal.HashBool(h, x.Empty != nil)
+	equal.HashBool(h, x.Timestamp != nil)
+	if p := x.Timestamp; p != nil {
+		equal.HashUint64(h, uint64(p.Seconds))
+		equal.HashUint64(h, uint64(p.Nanos))
+	}
+	equal.HashBool(h, x.FieldMask != nil)
+	if m, ok := interface{}(x.FieldMask).(interface{ Hash(*maphash.Hash) }); ok {
+		m.Hash(h)
+	}
+	equal.HashBool(h, x.Struct != nil)
+	equal.HashStruct(h, x.Struct, equal.UnknownCanonical)
+	equal.HashBool(h, x.Value != nil)
+	equal.HashValue(h, x.Value, equal.UnknownCanonical)
+	equal.HashBool(h, x.ListValue != nil)
+	equal.HashListValue(h, x.ListValue, equal.UnknownCanonical)
+	equal.HashBool(h, x.WrappersBoolValue != nil)
+	if p := x.WrappersBoolValue; p != nil {
+		equal.HashBool(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersBytesValue != nil)
+	if p := x.WrappersBytesValue; p != nil {
+		equal.HashBytes(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersDoubleValue != nil)
+	if p := x.WrappersDoubleValue; p != nil {
+		equal.HashFloat64(h, float64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersFloatValue != nil)
+	if p := x.WrappersFloatValue; p != nil {
+		equal.HashFloat64(h, float64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersInt32Value != nil)
+	if p := x.WrappersInt32Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersInt64Value != nil)
+	if p := x.WrappersInt64Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersStringValue != nil)
+	if p := x.WrappersStringValue; p != nil {
+		equal.HashString(h, p.Value)
+	}
+	equal.HashBool(h, x.WrappersUint32Value != nil)
+	if p := x.WrappersUint32Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashBool(h, x.WrappersUint64Value != nil)
+	if p := x.WrappersUint64Value; p != nil {
+		equal.HashUint64(h, uint64(p.Value))
+	}
+	equal.HashUint64(h, uint64(x.Enums3))
+	equal.HashBool(h, x.OtherMessage != nil)
+	if m, ok := interface{}(x.OtherMessage).(interface{ Hash(*maphash.Hash) }); ok {
+		m.Hash(h)
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *ForeignMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(x.C))
+	equal.HashUint64(h, uint64(x.D))
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *TestAllTypes_NestedMessage) Compare(y *TestAllTypes_NestedMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareBool(x.A != nil, y.A != nil); c != 0 {
+		return c
+	}
+	if p, q := x.A, y.A; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := x.Corecursive.Compare(y.Corecursive); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes) Compare(y *TestAllTypes) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareOrdered(x.SingularInt32, y.SingularInt32); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularInt64, y.SingularInt64); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularUint32, y.SingularUint32); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularUint64, y.SingularUint64); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularSint32, y.SingularSint32); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularSint64, y.SingularSint64); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularFixed32, y.SingularFixed32); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularFixed64, y.SingularFixed64); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularSfixed32, y.SingularSfixed32); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularSfixed64, y.SingularSfixed64); c != 0 {
+		return c
+	}
+	if c := equal.CompareFloat64(float64(x.SingularFloat), float64(y.SingularFloat)); c != 0 {
+		return c
+	}
+	if c := equal.CompareFloat64(float64(x.SingularDouble), float64(y.SingularDouble)); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.SingularBool, y.SingularBool); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularString, y.SingularString); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(x.SingularBytes, y.SingularBytes); c != 0 {
+		return c
+	}
+	if c := x.SingularNestedMessage.Compare(y.SingularNestedMessage); c != 0 {
+		return c
+	}
+	if c := x.SingularForeignMessage.Compare(y.SingularForeignMessage); c != 0 {
+		return c
+	}
+	if c := x.SingularImportMessage.Compare(y.SingularImportMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularNestedEnum, y.SingularNestedEnum); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularForeignEnum, y.SingularForeignEnum); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.SingularImportEnum, y.SingularImportEnum); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.OptionalInt32 != nil, y.OptionalInt32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalInt32, y.OptionalInt32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalInt64 != nil, y.OptionalInt64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalInt64, y.OptionalInt64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalUint32 != nil, y.OptionalUint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalUint32, y.OptionalUint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalUint64 != nil, y.OptionalUint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalUint64, y.OptionalUint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSint32 != nil, y.OptionalSint32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSint32, y.OptionalSint32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSint64 != nil, y.OptionalSint64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSint64, y.OptionalSint64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFixed32 != nil, y.OptionalFixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFixed32, y.OptionalFixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFixed64 != nil, y.OptionalFixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFixed64, y.OptionalFixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSfixed32 != nil, y.OptionalSfixed32 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSfixed32, y.OptionalSfixed32; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalSfixed64 != nil, y.OptionalSfixed64 != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalSfixed64, y.OptionalSfixed64; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalFloat != nil, y.OptionalFloat != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalFloat, y.OptionalFloat; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalDouble != nil, y.OptionalDouble != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalDouble, y.OptionalDouble; p != nil {
+		if c := equal.CompareFloat64(float64(*p), float64(*q)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalBool != nil, y.OptionalBool != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalBool, y.OptionalBool; p != nil {
+		if c := equal.CompareBool(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalString != nil, y.OptionalString != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalString, y.OptionalString; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalBytes != nil, y.OptionalBytes != nil); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(x.OptionalBytes, y.OptionalBytes); c != 0 {
+		return c
+	}
+	if c := x.OptionalNestedMessage.Compare(y.OptionalNestedMessage); c != 0 {
+		return c
+	}
+	if c := x.OptionalForeignMessage.Compare(y.OptionalForeignMessage); c != 0 {
+		return c
+	}
+	if c := x.OptionalImportMessage.Compare(y.OptionalImportMessage); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.OptionalNestedEnum != nil, y.OptionalNestedEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalNestedEnum, y.OptionalNestedEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalForeignEnum != nil, y.OptionalForeignEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalForeignEnum, y.OptionalForeignEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.OptionalImportEnum != nil, y.OptionalImportEnum != nil); c != 0 {
+		return c
+	}
+	if p, q := x.OptionalImportEnum, y.OptionalImportEnum; p != nil {
+		if c := equal.CompareOrdered(*p, *q); c != 0 {
+			return c
+		}
+	}
+	for i := 0; i < len(x.RepeatedInt32) && i < len(y.RepeatedInt32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedInt32[i], y.RepeatedInt32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedInt32), len(y.RepeatedInt32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedInt64) && i < len(y.RepeatedInt64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedInt64[i], y.RepeatedInt64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedInt64), len(y.RepeatedInt64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedUint32) && i < len(y.RepeatedUint32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedUint32[i], y.RepeatedUint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedUint32), len(y.RepeatedUint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedUint64) && i < len(y.RepeatedUint64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedUint64[i], y.RepeatedUint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedUint64), len(y.RepeatedUint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSint32) && i < len(y.RepeatedSint32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSint32[i], y.RepeatedSint32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSint32), len(y.RepeatedSint32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSint64) && i < len(y.RepeatedSint64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSint64[i], y.RepeatedSint64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSint64), len(y.RepeatedSint64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFixed32) && i < len(y.RepeatedFixed32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedFixed32[i], y.RepeatedFixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFixed32), len(y.RepeatedFixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFixed64) && i < len(y.RepeatedFixed64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedFixed64[i], y.RepeatedFixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFixed64), len(y.RepeatedFixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSfixed32) && i < len(y.RepeatedSfixed32); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSfixed32[i], y.RepeatedSfixed32[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSfixed32), len(y.RepeatedSfixed32)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedSfixed64) && i < len(y.RepeatedSfixed64); i++ {
+		if c := equal.CompareOrdered(x.RepeatedSfixed64[i], y.RepeatedSfixed64[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedSfixed64), len(y.RepeatedSfixed64)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedFloat) && i < len(y.RepeatedFloat); i++ {
+		if c := equal.CompareFloat64(float64(x.RepeatedFloat[i]), float64(y.RepeatedFloat[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedFloat), len(y.RepeatedFloat)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedDouble) && i < len(y.RepeatedDouble); i++ {
+		if c := equal.CompareFloat64(float64(x.RepeatedDouble[i]), float64(y.RepeatedDouble[i])); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedDouble), len(y.RepeatedDouble)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedBool) && i < len(y.RepeatedBool); i++ {
+		if c := equal.CompareBool(x.RepeatedBool[i], y.RepeatedBool[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedBool), len(y.RepeatedBool)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedString) && i < len(y.RepeatedString); i++ {
+		if c := equal.CompareOrdered(x.RepeatedString[i], y.RepeatedString[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedString), len(y.RepeatedString)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedBytes) && i < len(y.RepeatedBytes); i++ {
+		if c := bytes.Compare(x.RepeatedBytes[i], y.RepeatedBytes[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedBytes), len(y.RepeatedBytes)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedNestedMessage) && i < len(y.RepeatedNestedMessage); i++ {
+		if c := x.RepeatedNestedMessage[i].Compare(y.RepeatedNestedMessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedNestedMessage), len(y.RepeatedNestedMessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedForeignMessage) && i < len(y.RepeatedForeignMessage); i++ {
+		if c := x.RepeatedForeignMessage[i].Compare(y.RepeatedForeignMessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedForeignMessage), len(y.RepeatedForeignMessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedImportmessage) && i < len(y.RepeatedImportmessage); i++ {
+		if c := x.RepeatedImportmessage[i].Compare(y.RepeatedImportmessage[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedImportmessage), len(y.RepeatedImportmessage)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedNestedEnum) && i < len(y.RepeatedNestedEnum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedNestedEnum[i], y.RepeatedNestedEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedNestedEnum), len(y.RepeatedNestedEnum)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedForeignEnum) && i < len(y.RepeatedForeignEnum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedForeignEnum[i], y.RepeatedForeignEnum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedForeignEnum), len(y.RepeatedForeignEnum)); c != 0 {
+		return c
+	}
+	for i := 0; i < len(x.RepeatedImportenum) && i < len(y.RepeatedImportenum); i++ {
+		if c := equal.CompareOrdered(x.RepeatedImportenum[i], y.RepeatedImportenum[i]); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(len(x.RepeatedImportenum), len(y.RepeatedImportenum)); c != 0 {
+		return c
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Int32, y.MapInt32Int32) {
+		xv, xok := x.MapInt32Int32[k]
+		yv, yok := y.MapInt32Int32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt64Int64, y.MapInt64Int64) {
+		xv, xok := x.MapInt64Int64[k]
+		yv, yok := y.MapInt64Int64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapUint32Uint32, y.MapUint32Uint32) {
+		xv, xok := x.MapUint32Uint32[k]
+		yv, yok := y.MapUint32Uint32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapUint64Uint64, y.MapUint64Uint64) {
+		xv, xok := x.MapUint64Uint64[k]
+		yv, yok := y.MapUint64Uint64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSint32Sint32, y.MapSint32Sint32) {
+		xv, xok := x.MapSint32Sint32[k]
+		yv, yok := y.MapSint32Sint32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSint64Sint64, y.MapSint64Sint64) {
+		xv, xok := x.MapSint64Sint64[k]
+		yv, yok := y.MapSint64Sint64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
+		xv, xok := x.MapFixed32Fixed32[k]
+		yv, yok := y.MapFixed32Fixed32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		xv, xok := x.MapFixed64Fixed64[k]
+		yv, yok := y.MapFixed64Fixed64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
+		xv, xok := x.MapSfixed32Sfixed32[k]
+		yv, yok := y.MapSfixed32Sfixed32[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		xv, xok := x.MapSfixed64Sfixed64[k]
+		yv, yok := y.MapSfixed64Sfixed64[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Float, y.MapInt32Float) {
+		xv, xok := x.MapInt32Float[k]
+		yv, yok := y.MapInt32Float[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareFloat64(float64(xv), float64(yv)); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapInt32Double, y.MapInt32Double) {
+		xv, xok := x.MapInt32Double[k]
+		yv, yok := y.MapInt32Double[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareFloat64(float64(xv), float64(yv)); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedBoolKeys(x.MapBoolBool, y.MapBoolBool) {
+		xv, xok := x.MapBoolBool[k]
+		yv, yok := y.MapBoolBool[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareBool(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringString, y.MapStringString) {
+		xv, xok := x.MapStringString[k]
+		yv, yok := y.MapStringString[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringBytes, y.MapStringBytes) {
+		xv, xok := x.MapStringBytes[k]
+		yv, yok := y.MapStringBytes[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := bytes.Compare(xv, yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringNestedMessage, y.MapStringNestedMessage) {
+		xv, xok := x.MapStringNestedMessage[k]
+		yv, yok := y.MapStringNestedMessage[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := xv.Compare(yv); c != 0 {
+			return c
+		}
+	}
+	for _, k := range equal.SortedKeys(x.MapStringNestedEnum, y.MapStringNestedEnum) {
+		xv, xok := x.MapStringNestedEnum[k]
+		yv, yok := y.MapStringNestedEnum[k]
+		if xok != yok {
+			return equal.CompareBool(yok, xok)
+		}
+		if c := equal.CompareOrdered(xv, yv); c != 0 {
+			return c
+		}
+	}
+	switch xv := x.OneofField.(type) {
+	case nil:
+		if y.OneofField != nil {
+			return -1
+		}
+	case *TestAllTypes_OneofUint32:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofUint32:
+			if c := equal.CompareOrdered(xv.OneofUint32, yv.OneofUint32); c != 0 {
+				return c
+			}
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofNestedMessage:
+			if c := xv.OneofNestedMessage.Compare(yv.OneofNestedMessage); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofString:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofString:
+			if c := equal.CompareOrdered(xv.OneofString, yv.OneofString); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofBytes:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofBytes:
+			if c := bytes.Compare(xv.OneofBytes, yv.OneofBytes); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofBool:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofBool:
+			if c := equal.CompareBool(xv.OneofBool, yv.OneofBool); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofUint64:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofUint64:
+			if c := equal.CompareOrdered(xv.OneofUint64, yv.OneofUint64); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofFloat:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofFloat:
+			if c := equal.CompareFloat64(float64(xv.OneofFloat), float64(yv.OneofFloat)); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofDouble:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofDouble:
+			if c := equal.CompareFloat64(float64(xv.OneofDouble), float64(yv.OneofDouble)); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofEnum:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofEnum:
+			if c := equal.CompareOrdered(xv.OneofEnum, yv.OneofEnum); c != 0 {
+				return c
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case *TestAllTypes_OneofDouble:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		switch yv := y.OneofField.(type) {
+		case *TestAllTypes_OneofWrappersStringValue:
+			if c := equal.CompareBool(xv.OneofWrappersStringValue != nil, yv.OneofWrappersStringValue != nil); c != 0 {
+				return c
+			}
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; p != nil {
+				if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+					return c
+				}
+			}
+		case *TestAllTypes_OneofUint32:
+			return 1
+		case *TestAllTypes_OneofNestedMessage:
+			return 1
+		case *TestAllTypes_OneofString:
+			return 1
+		case *TestAllTypes_OneofBytes:
+			return 1
+		case *TestAllTypes_OneofBool:
+			return 1
+		case *TestAllTypes_OneofUint64:
+			return 1
+		case *TestAllTypes_OneofFloat:
+			return 1
+		case *TestAllTypes_OneofDouble:
+			return 1
+		case *TestAllTypes_OneofEnum:
+			return 1
+		case nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	if c := equal.CompareBool(x.Any != nil, y.Any != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Any, y.Any; p != nil {
+		if c := equal.CompareOrdered(p.TypeUrl, q.TypeUrl); c != 0 {
+			return c
+		}
+		if c := bytes.Compare(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Duration != nil, y.Duration != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Duration, y.Duration; p != nil {
+		if c := equal.CompareOrdered(p.Seconds, q.Seconds); c != 0 {
+			return c
+		}
+		if c := equal.CompareOrdered(p.Nanos, q.Nanos); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Empty != nil, y.Empty != nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.Timestamp != nil, y.Timestamp != nil); c != 0 {
+		return c
+	}
+	if p, q := x.Timestamp, y.Timestamp; p != nil {
+		if c := equal.CompareOrdered(p.Seconds, q.Seconds); c != 0 {
+			return c
+		}
+		if c := equal.CompareOrdered(p.Nanos, q.Nanos); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.FieldMask != nil, y.FieldMask != nil); c != 0 {
+		return c
+	}
+	if m, ok := interface{}(x.FieldMask).(interface {
+		Compare(*fieldmaskpb.FieldMask) int
+	}); ok {
+		if c := m.Compare(y.FieldMask); c != 0 {
+			return c
+		}
+	} else if x.FieldMask != nil {
+		if c := equal.CompareMessages(x.FieldMask, y.FieldMask); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.Struct != nil, y.Struct != nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareStruct(x.Struct, y.Struct, equal.UnknownCanonical); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.Value != nil, y.Value != nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareValue(x.Value, y.Value, equal.UnknownCanonical); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.ListValue != nil, y.ListValue != nil); c != 0 {
+		return c
+	}
+	if c := equal.CompareListValue(x.ListValue, y.ListValue, equal.UnknownCanonical); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.WrappersBoolValue != nil, y.WrappersBoolValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; p != nil {
+		if c := equal.CompareBool(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersBytesValue != nil, y.WrappersBytesValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; p != nil {
+		if c := bytes.Compare(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersDoubleValue != nil, y.WrappersDoubleValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; p != nil {
+		if c := equal.CompareFloat64(float64(p.Value), float64(q.Value)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersFloatValue != nil, y.WrappersFloatValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; p != nil {
+		if c := equal.CompareFloat64(float64(p.Value), float64(q.Value)); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersInt32Value != nil, y.WrappersInt32Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersInt64Value != nil, y.WrappersInt64Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersStringValue != nil, y.WrappersStringValue != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersUint32Value != nil, y.WrappersUint32Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareBool(x.WrappersUint64Value != nil, y.WrappersUint64Value != nil); c != 0 {
+		return c
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; p != nil {
+		if c := equal.CompareOrdered(p.Value, q.Value); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareOrdered(x.Enums3, y.Enums3); c != 0 {
+		return c
+	}
+	if c := equal.CompareBool(x.OtherMessage != nil, y.OtherMessage != nil); c != 0 {
+		return c
+	}
+	if m, ok := interface{}(x.OtherMessage).(interface{ Compare(*other.OtherMessage) int }); ok {
+		if c := m.Compare(y.OtherMessage); c != 0 {
+			return c
+		}
+	} else if x.OtherMessage != nil {
+		if c := equal.CompareMessages(x.OtherMessage, y.OtherMessage); c != 0 {
+			return c
+		}
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *ForeignMessage) Compare(y *ForeignMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareOrdered(x.C, y.C); c != 0 {
+		return c
+	}
+	if c := equal.CompareOrdered(x.D, y.D); c != 0 {
+		return c
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *TestAllTypes_NestedMessage) ChangedFields(y *TestAllTypes_NestedMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes_NestedMessage{}
+	}
+	if y == nil {
+		y = &TestAllTypes_NestedMessage{}
+	}
+	var paths []string
+	if !equal.OptionalEqual(x.A, y.A) {
+		paths = append(paths, "a")
+	}
+	if p, q := x.Corecursive, y.Corecursive; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "corecursive")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "corecursive", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "corecursive")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *TestAllTypes) ChangedFields(y *TestAllTypes) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &TestAllTypes{}
+	}
+	if y == nil {
+		y = &TestAllTypes{}
+	}
+	var paths []string
+	if x.SingularInt32 != y.SingularInt32 {
+		paths = append(paths, "singular_int32")
+	}
+	if x.SingularInt64 != y.SingularInt64 {
+		paths = append(paths, "singular_int64")
+	}
+	if x.SingularUint32 != y.SingularUint32 {
+		paths = append(paths, "singular_uint32")
+	}
+	if x.SingularUint64 != y.SingularUint64 {
+		paths = append(paths, "singular_uint64")
+	}
+	if x.SingularSint32 != y.SingularSint32 {
+		paths = append(paths, "singular_sint32")
+	}
+	if x.SingularSint64 != y.SingularSint64 {
+		paths = append(paths, "singular_sint64")
+	}
+	if x.SingularFixed32 != y.SingularFixed32 {
+		paths = append(paths, "singular_fixed32")
+	}
+	if x.SingularFixed64 != y.SingularFixed64 {
+		paths = append(paths, "singular_fixed64")
+	}
+	if x.SingularSfixed32 != y.SingularSfixed32 {
+		paths = append(paths, "singular_sfixed32")
+	}
+	if x.SingularSfixed64 != y.SingularSfixed64 {
+		paths = append(paths, "singular_sfixed64")
+	}
+	if !equal.FloatEqual(x.SingularFloat, y.SingularFloat) {
+		paths = append(paths, "singular_float")
+	}
+	if !equal.FloatEqual(x.SingularDouble, y.SingularDouble) {
+		paths = append(paths, "singular_double")
+	}
+	if x.SingularBool != y.SingularBool {
+		paths = append(paths, "singular_bool")
+	}
+	if x.SingularString != y.SingularString {
+		paths = append(paths, "singular_string")
+	}
+	if string(x.SingularBytes) != string(y.SingularBytes) {
+		paths = append(paths, "singular_bytes")
+	}
+	if p, q := x.SingularNestedMessage, y.SingularNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "singular_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "singular_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "singular_nested_message")
+	}
+	if p, q := x.SingularForeignMessage, y.SingularForeignMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "singular_foreign_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "singular_foreign_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "singular_foreign_message")
+	}
+	if p, q := x.SingularImportMessage, y.SingularImportMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "singular_import_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "singular_import_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "singular_import_message")
+	}
+	if x.SingularNestedEnum != y.SingularNestedEnum {
+		paths = append(paths, "singular_nested_enum")
+	}
+	if x.SingularForeignEnum != y.SingularForeignEnum {
+		paths = append(paths, "singular_foreign_enum")
+	}
+	if x.SingularImportEnum != y.SingularImportEnum {
+		paths = append(paths, "singular_import_enum")
+	}
+	if !equal.OptionalEqual(x.OptionalInt32, y.OptionalInt32) {
+		paths = append(paths, "optional_int32")
+	}
+	if !equal.OptionalEqual(x.OptionalInt64, y.OptionalInt64) {
+		paths = append(paths, "optional_int64")
+	}
+	if !equal.OptionalEqual(x.OptionalUint32, y.OptionalUint32) {
+		paths = append(paths, "optional_uint32")
+	}
+	if !equal.OptionalEqual(x.OptionalUint64, y.OptionalUint64) {
+		paths = append(paths, "optional_uint64")
+	}
+	if !equal.OptionalEqual(x.OptionalSint32, y.OptionalSint32) {
+		paths = append(paths, "optional_sint32")
+	}
+	if !equal.OptionalEqual(x.OptionalSint64, y.OptionalSint64) {
+		paths = append(paths, "optional_sint64")
+	}
+	if !equal.OptionalEqual(x.OptionalFixed32, y.OptionalFixed32) {
+		paths = append(paths, "optional_fixed32")
+	}
+	if !equal.OptionalEqual(x.OptionalFixed64, y.OptionalFixed64) {
+		paths = append(paths, "optional_fixed64")
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed32, y.OptionalSfixed32) {
+		paths = append(paths, "optional_sfixed32")
+	}
+	if !equal.OptionalEqual(x.OptionalSfixed64, y.OptionalSfixed64) {
+		paths = append(paths, "optional_sfixed64")
+	}
+	if !equal.OptionalFloatEqual(x.OptionalFloat, y.OptionalFloat) {
+		paths = append(paths, "optional_float")
+	}
+	if !equal.OptionalFloatEqual(x.OptionalDouble, y.OptionalDouble) {
+		paths = append(paths, "optional_double")
+	}
+	if !equal.OptionalEqual(x.OptionalBool, y.OptionalBool) {
+		paths = append(paths, "optional_bool")
+	}
+	if !equal.OptionalEqual(x.OptionalString, y.OptionalString) {
+		paths = append(paths, "optional_string")
+	}
+	if !equal.OptionalBytesEqual(x.OptionalBytes, y.OptionalBytes) {
+		paths = append(paths, "optional_bytes")
+	}
+	if p, q := x.OptionalNestedMessage, y.OptionalNestedMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_nested_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_nested_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_nested_message")
+	}
+	if p, q := x.OptionalForeignMessage, y.OptionalForeignMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_foreign_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_foreign_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_foreign_message")
+	}
+	if p, q := x.OptionalImportMessage, y.OptionalImportMessage; p == nil || q == nil {
+		if p != q {
+			paths = append(paths, "optional_import_message")
+		}
+	} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+		paths = equal.AppendNestedPaths(paths, "optional_import_message", nested)
+	} else if !p.Equal(q) {
+		paths = append(paths, "optional_import_message")
+	}
+	if !equal.OptionalEqual(x.OptionalNestedEnum, y.OptionalNestedEnum) {
+		paths = append(paths, "optional_nested_enum")
+	}
+	if !equal.OptionalEqual(x.OptionalForeignEnum, y.OptionalForeignEnum) {
+		paths = append(paths, "optional_foreign_enum")
+	}
+	if !equal.OptionalEqual(x.OptionalImportEnum, y.OptionalImportEnum) {
+		paths = append(paths, "optional_import_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedInt32, y.RepeatedInt32) {
+		paths = append(paths, "repeated_int32")
+	}
+	if !equal.SliceEqual(x.RepeatedInt64, y.RepeatedInt64) {
+		paths = append(paths, "repeated_int64")
+	}
+	if !equal.SliceEqual(x.RepeatedUint32, y.RepeatedUint32) {
+		paths = append(paths, "repeated_uint32")
+	}
+	if !equal.SliceEqual(x.RepeatedUint64, y.RepeatedUint64) {
+		paths = append(paths, "repeated_uint64")
+	}
+	if !equal.SliceEqual(x.RepeatedSint32, y.RepeatedSint32) {
+		paths = append(paths, "repeated_sint32")
+	}
+	if !equal.SliceEqual(x.RepeatedSint64, y.RepeatedSint64) {
+		paths = append(paths, "repeated_sint64")
+	}
+	if !equal.SliceEqual(x.RepeatedFixed32, y.RepeatedFixed32) {
+		paths = append(paths, "repeated_fixed32")
+	}
+	if !equal.SliceEqual(x.RepeatedFixed64, y.RepeatedFixed64) {
+		paths = append(paths, "repeated_fixed64")
+	}
+	if !equal.SliceEqual(x.RepeatedSfixed32, y.RepeatedSfixed32) {
+		paths = append(paths, "repeated_sfixed32")
+	}
+	if !equal.SliceEqual(x.RepeatedSfixed64, y.RepeatedSfixed64) {
+		paths = append(paths, "repeated_sfixed64")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedFloat, y.RepeatedFloat, equal.FloatEqual[float32]) {
+		paths = append(paths, "repeated_float")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedDouble, y.RepeatedDouble, equal.FloatEqual[float64]) {
+		paths = append(paths, "repeated_double")
+	}
+	if !equal.SliceEqual(x.RepeatedBool, y.RepeatedBool) {
+		paths = append(paths, "repeated_bool")
+	}
+	if !equal.SliceEqual(x.RepeatedString, y.RepeatedString) {
+		paths = append(paths, "repeated_string")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedBytes, y.RepeatedBytes, equal.BytesEqual) {
+		paths = append(paths, "repeated_bytes")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedNestedMessage, y.RepeatedNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		paths = append(paths, "repeated_nested_message")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedForeignMessage, y.RepeatedForeignMessage, (*ForeignMessage).Equal) {
+		paths = append(paths, "repeated_foreign_message")
+	}
+	if !equal.SliceEqualFunc(x.RepeatedImportmessage, y.RepeatedImportmessage, (*ImportMessage).Equal) {
+		paths = append(paths, "repeated_importmessage")
+	}
+	if !equal.SliceEqual(x.RepeatedNestedEnum, y.RepeatedNestedEnum) {
+		paths = append(paths, "repeated_nested_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedForeignEnum, y.RepeatedForeignEnum) {
+		paths = append(paths, "repeated_foreign_enum")
+	}
+	if !equal.SliceEqual(x.RepeatedImportenum, y.RepeatedImportenum) {
+		paths = append(paths, "repeated_importenum")
+	}
+	if !equal.MapEqual(x.MapInt32Int32, y.MapInt32Int32) {
+		paths = append(paths, "map_int32_int32")
+	}
+	if !equal.MapEqual(x.MapInt64Int64, y.MapInt64Int64) {
+		paths = append(paths, "map_int64_int64")
+	}
+	if !equal.MapEqual(x.MapUint32Uint32, y.MapUint32Uint32) {
+		paths = append(paths, "map_uint32_uint32")
+	}
+	if !equal.MapEqual(x.MapUint64Uint64, y.MapUint64Uint64) {
+		paths = append(paths, "map_uint64_uint64")
+	}
+	if !equal.MapEqual(x.MapSint32Sint32, y.MapSint32Sint32) {
+		paths = append(paths, "map_sint32_sint32")
+	}
+	if !equal.MapEqual(x.MapSint64Sint64, y.MapSint64Sint64) {
+		paths = append(paths, "map_sint64_sint64")
+	}
+	if !equal.MapEqual(x.MapFixed32Fixed32, y.MapFixed32Fixed32) {
+		paths = append(paths, "map_fixed32_fixed32")
+	}
+	if !equal.MapEqual(x.MapFixed64Fixed64, y.MapFixed64Fixed64) {
+		paths = append(paths, "map_fixed64_fixed64")
+	}
+	if !equal.MapEqual(x.MapSfixed32Sfixed32, y.MapSfixed32Sfixed32) {
+		paths = append(paths, "map_sfixed32_sfixed32")
+	}
+	if !equal.MapEqual(x.MapSfixed64Sfixed64, y.MapSfixed64Sfixed64) {
+		paths = append(paths, "map_sfixed64_sfixed64")
+	}
+	if !equal.MapEqualFunc(x.MapInt32Float, y.MapInt32Float, equal.FloatEqual[float32]) {
+		paths = append(paths, "map_int32_float")
+	}
+	if !equal.MapEqualFunc(x.MapInt32Double, y.MapInt32Double, equal.FloatEqual[float64]) {
+		paths = append(paths, "map_int32_double")
+	}
+	if !equal.MapEqual(x.MapBoolBool, y.MapBoolBool) {
+		paths = append(paths, "map_bool_bool")
+	}
+	if !equal.MapEqual(x.MapStringString, y.MapStringString) {
+		paths = append(paths, "map_string_string")
+	}
+	if !equal.MapEqualFunc(x.MapStringBytes, y.MapStringBytes, equal.BytesEqual) {
+		paths = append(paths, "map_string_bytes")
+	}
+	if !equal.MapEqualFunc(x.MapStringNestedMessage, y.MapStringNestedMessage, (*TestAllTypes_NestedMessage).Equal) {
+		paths = append(paths, "map_string_nested_message")
+	}
+	if !equal.MapEqual(x.MapStringNestedEnum, y.MapStringNestedEnum) {
+		paths = append(paths, "map_string_nested_enum")
+	}
+	switch xv := x.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			paths = append(paths, "oneof_uint32")
+		} else {
+			if xv.OneofUint32 != yv.OneofUint32 {
+				paths = append(paths, "oneof_uint32")
+			}
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			paths = append(paths, "oneof_nested_message")
+		} else {
+			if p, q := xv.OneofNestedMessage, yv.OneofNestedMessage; p == nil || q == nil {
+				if p != q {
+					paths = append(paths, "oneof_nested_message")
+				}
+			} else if nested := p.ChangedFields(q); len(nested.GetPaths()) > 0 {
+				paths = equal.AppendNestedPaths(paths, "oneof_nested_message", nested)
+			} else if !p.Equal(q) {
+				paths = append(paths, "oneof_nested_message")
+			}
+		}
+	case *TestAllTypes_OneofString:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofString); !ok {
+			paths = append(paths, "oneof_string")
+		} else {
+			if xv.OneofString != yv.OneofString {
+				paths = append(paths, "oneof_string")
+			}
+		}
+	case *TestAllTypes_OneofBytes:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			paths = append(paths, "oneof_bytes")
+		} else {
+			if string(xv.OneofBytes) != string(yv.OneofBytes) {
+				paths = append(paths, "oneof_bytes")
+			}
+		}
+	case *TestAllTypes_OneofBool:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofBool); !ok {
+			paths = append(paths, "oneof_bool")
+		} else {
+			if xv.OneofBool != yv.OneofBool {
+				paths = append(paths, "oneof_bool")
+			}
+		}
+	case *TestAllTypes_OneofUint64:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			paths = append(paths, "oneof_uint64")
+		} else {
+			if xv.OneofUint64 != yv.OneofUint64 {
+				paths = append(paths, "oneof_uint64")
+			}
+		}
+	case *TestAllTypes_OneofFloat:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			paths = append(paths, "oneof_float")
+		} else {
+			if !equal.FloatEqual(xv.OneofFloat, yv.OneofFloat) {
+				paths = append(paths, "oneof_float")
+			}
+		}
+	case *TestAllTypes_OneofDouble:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			paths = append(paths, "oneof_double")
+		} else {
+			if !equal.FloatEqual(xv.OneofDouble, yv.OneofDouble) {
+				paths = append(paths, "oneof_double")
+			}
+		}
+	case *TestAllTypes_OneofEnum:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			paths = append(paths, "oneof_enum")
+		} else {
+			if xv.OneofEnum != yv.OneofEnum {
+				paths = append(paths, "oneof_enum")
+			}
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if yv, ok := y.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			paths = append(paths, "oneof_wrappers_string_value")
+		} else {
+			if p, q := xv.OneofWrappersStringValue, yv.OneofWrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+				paths = append(paths, "oneof_wrappers_string_value")
+			}
+		}
+	}
+	switch y.OneofField.(type) {
+	case *TestAllTypes_OneofUint32:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofUint32); !ok {
+			paths = append(paths, "oneof_uint32")
+		}
+	case *TestAllTypes_OneofNestedMessage:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofNestedMessage); !ok {
+			paths = append(paths, "oneof_nested_message")
+		}
+	case *TestAllTypes_OneofString:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofString); !ok {
+			paths = append(paths, "oneof_string")
+		}
+	case *TestAllTypes_OneofBytes:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofBytes); !ok {
+			paths = append(paths, "oneof_bytes")
+		}
+	case *TestAllTypes_OneofBool:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofBool); !ok {
+			paths = append(paths, "oneof_bool")
+		}
+	case *TestAllTypes_OneofUint64:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofUint64); !ok {
+			paths = append(paths, "oneof_uint64")
+		}
+	case *TestAllTypes_OneofFloat:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofFloat); !ok {
+			paths = append(paths, "oneof_float")
+		}
+	case *TestAllTypes_OneofDouble:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofDouble); !ok {
+			paths = append(paths, "oneof_double")
+		}
+	case *TestAllTypes_OneofEnum:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofEnum); !ok {
+			paths = append(paths, "oneof_enum")
+		}
+	case *TestAllTypes_OneofWrappersStringValue:
+		if _, ok := x.OneofField.(*TestAllTypes_OneofWrappersStringValue); !ok {
+			paths = append(paths, "oneof_wrappers_string_value")
+		}
+	}
+	if p, q := x.Any, y.Any; (p == nil && q != nil) || (p != nil && (q == nil || p.TypeUrl != q.TypeUrl || string(p.Value) != string(q.Value))) {
+		paths = append(paths, "any")
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		paths = append(paths, "duration")
+	}
+	if p, q := x.Empty, y.Empty; (p == nil && q != nil) || (p != nil && q == nil) {
+		paths = append(paths, "empty")
+	}
+	if p, q := x.Timestamp, y.Timestamp; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		paths = append(paths, "timestamp")
+	}
+	if m, ok := interface{}(x.FieldMask).(interface {
+		ChangedFields(*fieldmaskpb.FieldMask) *fieldmaskpb.FieldMask
+	}); ok && x.FieldMask != nil && y.FieldMask != nil {
+		if nested := m.ChangedFields(y.FieldMask); len(nested.GetPaths()) > 0 {
+			paths = equal.AppendNestedPaths(paths, "field_mask", nested)
+		} else if m, ok := interface{}(x.FieldMask).(interface {
+			Equal(*fieldmaskpb.FieldMask) bool
+		}); (ok && !m.Equal(y.FieldMask)) || (!ok && !proto.Equal(x.FieldMask, y.FieldMask)) {
+			paths = append(paths, "field_mask")
+		}
+	} else if m, ok := interface{}(x.FieldMask).(interface {
+		Equal(*fieldmaskpb.FieldMask) bool
+	}); (ok && !m.Equal(y.FieldMask)) || (!ok && !proto.Equal(x.FieldMask, y.FieldMask)) {
+		paths = append(paths, "field_mask")
+	}
+	if !equal.StructEqual(x.Struct, y.Struct, equal.UnknownCanonical) {
+		paths = append(paths, "struct")
+	}
+	if !equal.ValueEqual(x.Value, y.Value, equal.UnknownCanonical) {
+		paths = append(paths, "value")
+	}
+	if !equal.ListValueEqual(x.ListValue, y.ListValue, equal.UnknownCanonical) {
+		paths = append(paths, "list_value")
+	}
+	if p, q := x.WrappersBoolValue, y.WrappersBoolValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_bool_value")
+	}
+	if p, q := x.WrappersBytesValue, y.WrappersBytesValue; (p == nil && q != nil) || (p != nil && (q == nil || string(p.Value) != string(q.Value))) {
+		paths = append(paths, "wrappers_bytes_value")
+	}
+	if p, q := x.WrappersDoubleValue, y.WrappersDoubleValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		paths = append(paths, "wrappers_double_value")
+	}
+	if p, q := x.WrappersFloatValue, y.WrappersFloatValue; (p == nil && q != nil) || (p != nil && (q == nil || !equal.FloatEqual(p.Value, q.Value))) {
+		paths = append(paths, "wrappers_float_value")
+	}
+	if p, q := x.WrappersInt32Value, y.WrappersInt32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_int32_value")
+	}
+	if p, q := x.WrappersInt64Value, y.WrappersInt64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_int64_value")
+	}
+	if p, q := x.WrappersStringValue, y.WrappersStringValue; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_string_value")
+	}
+	if p, q := x.WrappersUint32Value, y.WrappersUint32Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_uint32_value")
+	}
+	if p, q := x.WrappersUint64Value, y.WrappersUint64Value; (p == nil && q != nil) || (p != nil && (q == nil || p.Value != q.Value)) {
+		paths = append(paths, "wrappers_uint64_value")
+	}
+	if x.Enums3 != y.Enums3 {
+		paths = append(paths, "enums3")
+	}
+	if m, ok := interface{}(x.OtherMessage).(interface {
+		ChangedFields(*other.OtherMessage) *fieldmaskpb.FieldMask
+	}); ok && x.OtherMessage != nil && y.OtherMessage != nil {
+		if nested := m.ChangedFields(y.OtherMessage); len(nested.GetPaths()) > 0 {
+			paths = equal.AppendNestedPaths(paths, "other_message", nested)
+		} else if m, ok := interface{}(x.OtherMessage).(interface {
+			Equal(*other.OtherMessage) bool
+		}); (ok && !m.Equal(y.OtherMessage)) || (!ok && !proto.Equal(x.OtherMessage, y.OtherMessage)) {
+			paths = append(paths, "other_message")
+		}
+	} else if m, ok := interface{}(x.OtherMessage).(interface {
+		Equal(*other.OtherMessage) bool
+	}); (ok && !m.Equal(y.OtherMessage)) || (!ok && !proto.Equal(x.OtherMessage, y.OtherMessage)) {
+		paths = append(paths, "other_message")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func (x *ForeignMessage) ChangedFields(y *ForeignMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &ForeignMessage{}
+	}
+	if y == nil {
+		y = &ForeignMessage{}
+	}
+	var paths []string
+	if x.C != y.C {
+		paths = append(paths, "c")
+	}
+	if x.D != y.D {
+		paths = append(paths, "d")
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -1,4 +0,0 @@
-// Code generated by protoc-gen-equal-go. DO NOT EDIT.
-// source: internal/testprotos/test3/test_extension.proto
-
-package test3
@@ -3,6 +3,12 @@
 
 package test3
 
+import (
+	equal "github.com/melias122/protoc-gen-go-equal/equal"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maphash "hash/maphash"
+)
+
 func (x *ImportMessage) Equal(y *ImportMessage) bool {
 	if x == y {
 		return true
@@ -10,5 +16,66 @@ func (x *ImportMessage) Equal(y *ImportMessage) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		return false
+	}
 	return true
 }
+
+func init() {
+	equal.Register("goproto.proto.test3.ImportMessage", (*ImportMessage).Equal)
+}
+
+func (x *ImportMessage) Diff(y *ImportMessage) []equal.Difference {
+	if x == y {
+		return nil
+	}
+	if x == nil {
+		return []equal.Difference{{Y: y}}
+	}
+	if y == nil {
+		return []equal.Difference{{X: x}}
+	}
+	var d []equal.Difference
+	if !equal.UnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()) {
+		d = append(d, equal.Difference{Path: "<unknown>", X: []byte(x.ProtoReflect().GetUnknown()), Y: []byte(y.ProtoReflect().GetUnknown())})
+	}
+	return d
+}
+
+func (x *ImportMessage) Hash(h *maphash.Hash) {
+	if x == nil {
+		return
+	}
+	equal.HashUint64(h, uint64(len(x.ProtoReflect().GetUnknown())))
+}
+
+func (x *ImportMessage) Compare(y *ImportMessage) int {
+	if x == y {
+		return 0
+	}
+	if x == nil {
+		return -1
+	}
+	if y == nil {
+		return 1
+	}
+	if c := equal.CompareUnknownFields(x.ProtoReflect().GetUnknown(), y.ProtoReflect().GetUnknown()); c != 0 {
+		return c
+	}
+	return 0
+}
+
+func (x *ImportMessage) ChangedFields(y *ImportMessage) *fieldmaskpb.FieldMask {
+	if x == y {
+		return &fieldmaskpb.FieldMask{}
+	}
+	if x == nil {
+		x = &ImportMessage{}
+	}
+	if y == nil {
+		y = &ImportMessage{}
+	}
+	var paths []string
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -5,10 +5,15 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var isLocalPackage = make(map[string]bool)
+var (
+	isLocalPackage  = make(map[string]bool)
+	localExtensions = make(map[protoreflect.FullName][]*protogen.Extension)
+)
 
 func main() {
-	opts := protogen.Options{}
+	opts := protogen.Options{
+		ParamFunc: params.set,
+	}
 	opts.Run(func(gen *protogen.Plugin) error {
 
 		for _, f := range gen.Files {
@@ -16,6 +21,7 @@ func main() {
 				continue
 			}
 			isLocalPackage[string(f.Desc.Package())] = true
+			collectExtensions(f.Extensions, f.Messages)
 		}
 
 		for _, f := range gen.Files {
@@ -23,7 +29,14 @@ func main() {
 				continue
 			}
 
-			out := f.GeneratedFilenamePrefix + "_equal.pb.go"
+			if err := checkOptions(f); err != nil {
+				return err
+			}
+			if !anyGenerated(f.Messages) {
+				continue
+			}
+
+			out := f.GeneratedFilenamePrefix + params.suffix + ".pb.go"
 			g := gen.NewGeneratedFile(out, f.GoImportPath)
 
 			g.P(`// Code generated by protoc-gen-equal-go. DO NOT EDIT.`)
@@ -33,7 +46,31 @@ func main() {
 
 			proto3 := f.Desc.Syntax() == protoreflect.Proto3
 			genEqual(g, f.Messages, proto3)
+			genEqualRegistry(g, f.Messages)
+			if params.diff {
+				genDiff(g, f.Messages, proto3)
+			}
+			if params.hash {
+				genHash(g, f.Messages, proto3)
+			}
+			if params.compare {
+				genCompare(g, f.Messages, proto3)
+			}
+			if params.changedFields {
+				genChangedFields(g, f.Messages, proto3)
+			}
 		}
 		return nil
 	})
 }
+
+// collectExtensions indexes extensions by the full name of the extended message.
+func collectExtensions(extensions []*protogen.Extension, messages []*protogen.Message) {
+	for _, e := range extensions {
+		extendee := e.Extendee.Desc.FullName()
+		localExtensions[extendee] = append(localExtensions[extendee], e)
+	}
+	for _, m := range messages {
+		collectExtensions(m.Extensions, m.Messages)
+	}
+}
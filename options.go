@@ -0,0 +1,370 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/melias122/protoc-gen-go-equal/equal"
+)
+
+// fieldOptions returns the (equal.field) options of f, or nil if unset.
+func fieldOptions(f *protogen.Field) *equal.FieldOptions {
+	opts, _ := proto.GetExtension(f.Desc.Options(), equal.E_Field).(*equal.FieldOptions)
+	return opts
+}
+
+// isIgnored reports whether f is excluded from the generated methods.
+func isIgnored(f *protogen.Field) bool {
+	return fieldOptions(f).GetIgnore()
+}
+
+// messageOptions returns the (equal.message) options of md, or nil if unset.
+func messageOptions(md protoreflect.MessageDescriptor) *equal.MessageOptions {
+	opts, _ := proto.GetExtension(md.Options(), equal.E_Message).(*equal.MessageOptions)
+	return opts
+}
+
+// fileOptions returns the (equal.file) options of fd, or nil if unset.
+func fileOptions(fd protoreflect.FileDescriptor) *equal.FileOptions {
+	opts, _ := proto.GetExtension(fd.Options(), equal.E_File).(*equal.FileOptions)
+	return opts
+}
+
+// isGenerated reports whether methods are generated for md. The closest
+// (equal.message) options of md or its enclosing messages win over the
+// (equal.file) options, which win over the default parameter.
+func isGenerated(md protoreflect.MessageDescriptor) bool {
+	for d := protoreflect.Descriptor(md); d != nil; d = d.Parent() {
+		switch d := d.(type) {
+		case protoreflect.MessageDescriptor:
+			if opts := messageOptions(d); opts.GetEnabled() || opts.GetDisabled() {
+				return opts.GetEnabled()
+			}
+		case protoreflect.FileDescriptor:
+			if opts := fileOptions(d); opts.GetEnabled() || opts.GetDisabled() {
+				return opts.GetEnabled()
+			}
+		}
+	}
+	return params.defaultMode == defaultEnabled
+}
+
+// anyGenerated reports whether methods are generated for any of messages.
+func anyGenerated(messages []*protogen.Message) bool {
+	for _, m := range messages {
+		if !m.Desc.IsMapEntry() && isGenerated(m.Desc) || anyGenerated(m.Messages) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnordered reports whether repeated field f is compared as a multiset.
+func isUnordered(f *protogen.Field) bool {
+	return fieldOptions(f).GetUnordered()
+}
+
+// floatTolerance returns the (equal.field).tolerance of float field f, or nil
+// if unset or f is not a float field.
+func floatTolerance(f *protogen.Field) *equal.Tolerance {
+	if !isFloatField(f) {
+		return nil
+	}
+	return valueFieldOptions(f).GetTolerance()
+}
+
+// timeTolerance returns the (equal.field).time_tolerance of Timestamp or
+// Duration field f. It reports false if unset, invalid or f is not such a
+// field.
+func timeTolerance(f *protogen.Field) (time.Duration, bool) {
+	s := valueFieldOptions(f).GetTimeTolerance()
+	if !isTimeField(f) || s == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(s)
+	return d, err == nil && d >= 0
+}
+
+// isAnyTypeName reports whether type URLs of Any field f are compared only
+// by message names, by (equal.field).type_url or the type_url parameter.
+func isAnyTypeName(f *protogen.Field) bool {
+	switch valueFieldOptions(f).GetTypeUrl() {
+	case equal.TypeURL_TYPE_URL_EXACT:
+		return false
+	case equal.TypeURL_TYPE_URL_NAME:
+		return true
+	}
+	return params.typeURL == typeURLName
+}
+
+// stringMode returns the (equal.field).string_mode of string field f. Keys of
+// map fields use it only with (equal.field).string_mode_keys.
+func stringMode(f *protogen.Field) equal.StringMode {
+	if f.Desc.Kind() != protoreflect.StringKind {
+		return equal.StringMode_STRING_MODE_EXACT
+	}
+	opts := valueFieldOptions(f)
+	if isMapKey(f) && !opts.GetStringModeKeys() {
+		return equal.StringMode_STRING_MODE_EXACT
+	}
+	return opts.GetStringMode()
+}
+
+// stringFunc returns the runtime function comparing, hashing or normalizing
+// strings in mode, e.g. stringFunc(g, mode, "StringEqual%s") is
+// equal.StringEqualFold for STRING_MODE_CASE_FOLD.
+func stringFunc(g *protogen.GeneratedFile, mode equal.StringMode, format string) string {
+	suffix := "NFC"
+	if mode == equal.StringMode_STRING_MODE_CASE_FOLD {
+		suffix = "Fold"
+	}
+	return g.QualifiedGoIdent(equalPackage.Ident(fmt.Sprintf(format, suffix)))
+}
+
+// contentType returns the (equal.field).content_type of bytes field f, or ""
+// if unset or f is not a bytes field.
+func contentType(f *protogen.Field) string {
+	if f.Desc.Kind() != protoreflect.BytesKind {
+		return ""
+	}
+	return valueFieldOptions(f).GetContentType()
+}
+
+// contentFunc returns the call of the runtime function comparing or ordering
+// values x and y of bytes field f by their content type, e.g.
+// contentFunc(g, f, "%sEqual", x, y) is equal.JSONEqual(x, y) for "json".
+func contentFunc(g *protogen.GeneratedFile, f *protogen.Field, format, x, y string) string {
+	if ct := contentType(f); ct != "json" {
+		return g.QualifiedGoIdent(equalPackage.Ident(fmt.Sprintf(format, "MessageBytes"))) + `(` + x + `, ` + y + `, ` + strconv.Quote(ct) + `)`
+	}
+	return g.QualifiedGoIdent(equalPackage.Ident(fmt.Sprintf(format, "JSON"))) + `(` + x + `, ` + y + `)`
+}
+
+// normalizesKeys reports whether keys of map field f are compared under
+// (equal.field).string_mode.
+func normalizesKeys(f *protogen.Field) bool {
+	return f.Desc.IsMap() && stringMode(f.Message.Fields[0]) != equal.StringMode_STRING_MODE_EXACT
+}
+
+// isMapKey reports whether f is the key field of a map entry.
+func isMapKey(f *protogen.Field) bool {
+	entry, ok := f.Desc.Parent().(protoreflect.MessageDescriptor)
+	return ok && entry.IsMapEntry() && f.Desc.Number() == 1
+}
+
+// valueFieldOptions returns the (equal.field) options applying to values of
+// f. Values of map fields use the options of the map field.
+func valueFieldOptions(f *protogen.Field) *equal.FieldOptions {
+	fd := f.Desc
+	if entry, ok := fd.Parent().(protoreflect.MessageDescriptor); ok && entry.IsMapEntry() {
+		if parent, ok := entry.Parent().(protoreflect.MessageDescriptor); ok {
+			fields := parent.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				if mf := fields.Get(i); mf.IsMap() && mf.Message().FullName() == entry.FullName() {
+					fd = mf
+				}
+			}
+		}
+	}
+	opts, _ := proto.GetExtension(fd.Options(), equal.E_Field).(*equal.FieldOptions)
+	return opts
+}
+
+// isFloatField reports whether values of f are floats or float wrappers.
+func isFloatField(f *protogen.Field) bool {
+	switch f.Desc.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	case protoreflect.MessageKind:
+		return f.Message.Desc.FullName() == "google.protobuf.FloatValue" || f.Message.Desc.FullName() == "google.protobuf.DoubleValue"
+	}
+	return false
+}
+
+// isTimeField reports whether values of f are google.protobuf.Timestamp or
+// Duration messages.
+func isTimeField(f *protogen.Field) bool {
+	if f.Message == nil {
+		return false
+	}
+	name := f.Message.Desc.FullName()
+	return name == "google.protobuf.Timestamp" || name == "google.protobuf.Duration"
+}
+
+// isAnyField reports whether values of f are google.protobuf.Any messages.
+func isAnyField(f *protogen.Field) bool {
+	return f.Message != nil && f.Message.Desc.FullName() == "google.protobuf.Any"
+}
+
+// keyField returns the field of the elements of repeated message field f
+// named by (equal.field).key, or nil if unset or invalid.
+func keyField(f *protogen.Field) *protogen.Field {
+	key := fieldOptions(f).GetKey()
+	if key == "" || f.Message == nil || !f.Desc.IsList() {
+		return nil
+	}
+	for _, kf := range f.Message.Fields {
+		if string(kf.Desc.Name()) == key && isKeyKind(kf.Desc.Kind()) && !kf.Desc.IsList() && !kf.Desc.IsMap() {
+			return kf
+		}
+	}
+	return nil
+}
+
+// isKeyKind reports whether fields of kind can be used as keys.
+func isKeyKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.FloatKind, protoreflect.DoubleKind,
+		protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+// keyType returns the Go type of keys of key field kf.
+func keyType(g *protogen.GeneratedFile, kf *protogen.Field) string {
+	if kf.Desc.Kind() == protoreflect.BytesKind {
+		return "string"
+	}
+	return goType(g, kf)
+}
+
+// keyFunc returns a function literal returning the key of elements of
+// repeated field f.
+func keyFunc(g *protogen.GeneratedFile, f *protogen.Field) string {
+	kf := keyField(f)
+	get := `v.Get` + kf.GoName + `()`
+	if kf.Desc.Kind() == protoreflect.BytesKind {
+		get = `string(` + get + `)`
+	}
+	return `func(v ` + goType(g, f) + `) ` + keyType(g, kf) + ` { return ` + get + ` }`
+}
+
+// checkOptions reports invalid combinations of options in file f.
+func checkOptions(f *protogen.File) error {
+	if opts := fileOptions(f.Desc); opts.GetEnabled() && opts.GetDisabled() {
+		return fmt.Errorf("%s: (equal.file).enabled and (equal.file).disabled are mutually exclusive", f.Desc.Path())
+	}
+	return checkMessageOptions(f.Messages)
+}
+
+// checkMessageOptions reports invalid combinations of options in messages.
+func checkMessageOptions(messages []*protogen.Message) error {
+	for _, m := range messages {
+		if opts := messageOptions(m.Desc); opts.GetEnabled() && opts.GetDisabled() {
+			return fmt.Errorf("%s: (equal.message).enabled and (equal.message).disabled are mutually exclusive", m.Desc.FullName())
+		}
+		for _, f := range m.Fields {
+			if isUnordered(f) && !f.Desc.IsList() {
+				return fmt.Errorf("%s: (equal.field).unordered requires a repeated field", f.Desc.FullName())
+			}
+			if opts := fieldOptions(f); opts.GetUnordered() || opts.GetKey() != "" {
+				// Matching elements needs a transitive equality
+				matching, tolerance := "unordered", ""
+				if !opts.GetUnordered() {
+					matching = "key"
+				}
+				switch {
+				case opts.GetTolerance() != nil:
+					tolerance = "tolerance"
+				case opts.GetTimeTolerance() != "":
+					tolerance = "time_tolerance"
+				}
+				if tolerance != "" {
+					return fmt.Errorf("%s: (equal.field).%s and (equal.field).%s are mutually exclusive", f.Desc.FullName(), tolerance, matching)
+				}
+			}
+			if tolerance := fieldOptions(f).GetTolerance(); tolerance != nil {
+				values := f
+				if f.Desc.IsMap() {
+					values = f.Message.Fields[1]
+				}
+				if !isFloatField(values) {
+					return fmt.Errorf("%s: (equal.field).tolerance requires a float or double field", f.Desc.FullName())
+				}
+				if tolerance.GetAbsolute() < 0 || tolerance.GetRelative() < 0 {
+					return fmt.Errorf("%s: (equal.field).tolerance must not be negative", f.Desc.FullName())
+				}
+			}
+			if s := fieldOptions(f).GetTimeTolerance(); s != "" {
+				values := f
+				if f.Desc.IsMap() {
+					values = f.Message.Fields[1]
+				}
+				if !isTimeField(values) {
+					return fmt.Errorf("%s: (equal.field).time_tolerance requires a google.protobuf.Timestamp or Duration field", f.Desc.FullName())
+				}
+				if d, err := time.ParseDuration(s); err != nil || d < 0 {
+					return fmt.Errorf("%s: (equal.field).time_tolerance must be a non-negative duration like \"1ms\", got %q", f.Desc.FullName(), s)
+				}
+			}
+			if fieldOptions(f).GetTypeUrl() != equal.TypeURL_TYPE_URL_UNSPECIFIED {
+				values := f
+				if f.Desc.IsMap() {
+					values = f.Message.Fields[1]
+				}
+				if !isAnyField(values) {
+					return fmt.Errorf("%s: (equal.field).type_url requires a google.protobuf.Any field", f.Desc.FullName())
+				}
+			}
+			if opts := fieldOptions(f); opts.GetStringMode() != equal.StringMode_STRING_MODE_EXACT || opts.GetStringModeKeys() {
+				values := f
+				if f.Desc.IsMap() {
+					values = f.Message.Fields[1]
+				}
+				if opts.GetStringModeKeys() && (!f.Desc.IsMap() || f.Message.Fields[0].Desc.Kind() != protoreflect.StringKind) {
+					return fmt.Errorf("%s: (equal.field).string_mode_keys requires a map field with string keys", f.Desc.FullName())
+				}
+				if opts.GetStringMode() == equal.StringMode_STRING_MODE_EXACT {
+					return fmt.Errorf("%s: (equal.field).string_mode_keys requires (equal.field).string_mode", f.Desc.FullName())
+				}
+				if values.Desc.Kind() != protoreflect.StringKind && !opts.GetStringModeKeys() {
+					return fmt.Errorf("%s: (equal.field).string_mode requires a string field", f.Desc.FullName())
+				}
+				if opts.GetStringModeKeys() && (opts.GetTolerance() != nil || opts.GetTimeTolerance() != "") {
+					// Entries with normalized keys are matched like unordered elements
+					return fmt.Errorf("%s: (equal.field).string_mode_keys and tolerances are mutually exclusive", f.Desc.FullName())
+				}
+			}
+			if ct := fieldOptions(f).GetContentType(); ct != "" {
+				values := f
+				if f.Desc.IsMap() {
+					values = f.Message.Fields[1]
+				}
+				if values.Desc.Kind() != protoreflect.BytesKind {
+					return fmt.Errorf("%s: (equal.field).content_type requires a bytes field", f.Desc.FullName())
+				}
+				if ct != "json" && !protoreflect.FullName(ct).IsValid() {
+					return fmt.Errorf("%s: (equal.field).content_type must be \"json\" or a fully-qualified message name, got %q", f.Desc.FullName(), ct)
+				}
+			}
+			if key := fieldOptions(f).GetKey(); key != "" {
+				if isUnordered(f) {
+					return fmt.Errorf("%s: (equal.field).key and (equal.field).unordered are mutually exclusive", f.Desc.FullName())
+				}
+				if keyField(f) == nil {
+					return fmt.Errorf("%s: (equal.field).key requires a repeated message field whose elements have a singular non-float scalar field %q", f.Desc.FullName(), key)
+				}
+			}
+		}
+		for _, o := range m.Oneofs {
+			if o.Desc.IsSynthetic() {
+				continue
+			}
+			for _, f := range o.Fields {
+				if isIgnored(f) != isIgnored(o.Fields[0]) {
+					return fmt.Errorf("%s: (equal.field).ignore must be set on all or none of the fields of oneof %s", f.Desc.FullName(), o.Desc.Name())
+				}
+			}
+		}
+		if err := checkMessageOptions(m.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/melias122/protoc-gen-go-equal/equal"
+	optionspb "github.com/melias122/protoc-gen-go-equal/internal/testprotos/options"
+)
+
+// newTestFile returns the plugin file generated from file with its
+// dependencies taken from the compiled in descriptors.
+func newTestFile(t *testing.T, file *descriptorpb.FileDescriptorProto) *protogen.File {
+	t.Helper()
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			protodesc.ToFileDescriptorProto(anypb.File_google_protobuf_any_proto),
+			protodesc.ToFileDescriptorProto(durationpb.File_google_protobuf_duration_proto),
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+			protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto),
+			protodesc.ToFileDescriptorProto(equal.File_equal_equal_proto),
+			file,
+		},
+	}
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen.FilesByPath[file.GetName()]
+}
+
+func TestCheckOptions(t *testing.T) {
+	file := protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	if err := checkOptions(newTestFile(t, file)); err != nil {
+		t.Fatalf("checkOptions() = %v, want nil", err)
+	}
+
+	// Ignore only one of the oneof fields
+	file = proto.Clone(file).(*descriptorpb.FileDescriptorProto)
+	for _, f := range file.MessageType[0].Field {
+		if f.GetName() == "deleted" {
+			f.Options = nil
+		}
+	}
+	err := checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "oneof state") {
+		t.Fatalf("checkOptions() = %v, want oneof error", err)
+	}
+
+	// Unordered singular field
+	file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	for _, f := range file.MessageType[0].Field {
+		if f.GetName() == "id" {
+			f.Options = &descriptorpb.FieldOptions{}
+			proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{Unordered: true})
+		}
+	}
+	err = checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "requires a repeated field") {
+		t.Fatalf("checkOptions() = %v, want unordered error", err)
+	}
+
+	// Tolerance on a non-float field
+	file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	for _, f := range file.MessageType[0].Field {
+		if f.GetName() == "id" {
+			f.Options = &descriptorpb.FieldOptions{}
+			proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{Tolerance: &equal.Tolerance{Absolute: 1}})
+		}
+	}
+	err = checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "tolerance") {
+		t.Fatalf("checkOptions() = %v, want tolerance error", err)
+	}
+
+	// Unordered fields with tolerance
+	for _, tt := range []struct {
+		message, field string
+		opts           *equal.FieldOptions
+		want           string
+	}{
+		{"Telemetry", "relative", &equal.FieldOptions{Unordered: true, Tolerance: &equal.Tolerance{Relative: 1e-9}}, "tolerance and (equal.field).unordered"},
+		{"Schedule", "steps", &equal.FieldOptions{Unordered: true, TimeTolerance: "1s"}, "time_tolerance and (equal.field).unordered"},
+	} {
+		file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+		for _, m := range file.MessageType {
+			for _, f := range m.Field {
+				if m.GetName() == tt.message && f.GetName() == tt.field {
+					f.Options = &descriptorpb.FieldOptions{}
+					proto.SetExtension(f.Options, equal.E_Field, tt.opts)
+				}
+			}
+		}
+		err = checkOptions(newTestFile(t, file))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("checkOptions() = %v, want %q error", err, tt.want)
+		}
+	}
+
+	// Time tolerance that is not a duration
+	file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	for _, m := range file.MessageType {
+		for _, f := range m.Field {
+			if m.GetName() == "Schedule" && f.GetName() == "start" {
+				proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{TimeTolerance: "1 day"})
+			}
+		}
+	}
+	err = checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "time_tolerance") {
+		t.Fatalf("checkOptions() = %v, want time_tolerance error", err)
+	}
+
+	// String mode of map keys without string keys
+	file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	for _, m := range file.MessageType {
+		for _, f := range m.Field {
+			if m.GetName() == "Contact" && f.GetName() == "hosts" {
+				proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{StringMode: equal.StringMode_STRING_MODE_CASE_FOLD, StringModeKeys: true})
+			}
+		}
+	}
+	err = checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "string_mode_keys") {
+		t.Fatalf("checkOptions() = %v, want string_mode_keys error", err)
+	}
+
+	// String mode of map keys with tolerant values
+	file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	for _, m := range file.MessageType {
+		for _, f := range m.Field {
+			if m.GetName() == "Telemetry" && f.GetName() == "values" {
+				proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{
+					StringMode:     equal.StringMode_STRING_MODE_CASE_FOLD,
+					StringModeKeys: true,
+					Tolerance:      &equal.Tolerance{Absolute: 0.5},
+				})
+			}
+		}
+	}
+	err = checkOptions(newTestFile(t, file))
+	if err == nil || !strings.Contains(err.Error(), "string_mode_keys and tolerances") {
+		t.Fatalf("checkOptions() = %v, want string_mode_keys error", err)
+	}
+
+	// Content type on a string field and an invalid content type
+	for _, tt := range []struct{ field, contentType, want string }{
+		{"meta_text", "json", "requires a bytes field"},
+		{"raw", "application/json", "fully-qualified message name"},
+	} {
+		file = protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+		for _, m := range file.MessageType {
+			for _, f := range m.Field {
+				if m.GetName() == "Document" && f.GetName() == tt.field {
+					f.Options = &descriptorpb.FieldOptions{}
+					proto.SetExtension(f.Options, equal.E_Field, &equal.FieldOptions{ContentType: tt.contentType})
+				}
+			}
+		}
+		err = checkOptions(newTestFile(t, file))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("checkOptions() = %v, want content_type error %q", err, tt.want)
+		}
+	}
+}
+
+func TestIsGenerated(t *testing.T) {
+	defer func(mode string) { params.defaultMode = mode }(params.defaultMode)
+
+	tests := []struct {
+		mode string
+		msg  protoreflect.MessageDescriptor
+		want bool
+	}{
+		{defaultEnabled, (&optionspb.Resource{}).ProtoReflect().Descriptor(), true},
+		{defaultDisabled, (&optionspb.Resource{}).ProtoReflect().Descriptor(), false},
+		{defaultEnabled, (&optionspb.Disabled{}).ProtoReflect().Descriptor(), false},
+		{defaultDisabled, (&optionspb.Enabled{}).ProtoReflect().Descriptor(), true},
+		{defaultDisabled, (&optionspb.Enabled_Nested{}).ProtoReflect().Descriptor(), true},
+		{defaultEnabled, (&optionspb.Enabled_Excluded{}).ProtoReflect().Descriptor(), false},
+	}
+	for _, tt := range tests {
+		params.defaultMode = tt.mode
+		if got := isGenerated(tt.msg); got != tt.want {
+			t.Errorf("isGenerated(%s) with default=%s = %v, want %v", tt.msg.FullName(), tt.mode, got, tt.want)
+		}
+	}
+
+	params.defaultMode = defaultDisabled
+	file := protodesc.ToFileDescriptorProto(optionspb.File_internal_testprotos_options_options_proto)
+	if anyGenerated(newTestFile(t, file).Messages) {
+		t.Errorf("anyGenerated(%s) with default=disabled = true, want false", file.GetName())
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Unknown fields comparison modes
+const (
+	unknownIgnore    = "ignore"
+	unknownRaw       = "raw"
+	unknownCanonical = "canonical"
+)
+
+// Float comparison policies
+const (
+	floatEqual = "equal"
+	floatProto = "proto"
+	floatBits  = "bits"
+)
+
+// Timestamp and Duration comparison modes
+const (
+	timeRaw        = "raw"
+	timeNormalized = "normalized"
+)
+
+// google.protobuf.Any comparison modes
+const (
+	anyRaw    = "raw"
+	anyUnpack = "unpack"
+)
+
+// Type URL comparison modes of google.protobuf.Any values
+const (
+	typeURLExact = "exact"
+	typeURLName  = "name"
+)
+
+// google.protobuf.FieldMask comparison modes
+const (
+	fieldMaskRaw        = "raw"
+	fieldMaskNormalized = "normalized"
+)
+
+// Default generation modes
+const (
+	defaultEnabled  = "enabled"
+	defaultDisabled = "disabled"
+)
+
+// parameters holds the plugin parameters passed by protoc or buf,
+// e.g. --go-equal_opt=unknown=raw,float=proto,time=normalized,any=unpack,type_url=name,field_mask=normalized,diff=true,hash=true,compare=true,changed_fields=true,method=EqualVT,suffix=_eq
+type parameters struct {
+	// unknown selects how unknown fields are compared
+	unknown string
+
+	// float selects how float and double values are compared
+	float string
+
+	// time selects how google.protobuf.Timestamp and Duration values are compared
+	time string
+
+	// any selects how google.protobuf.Any values are compared
+	any string
+
+	// typeURL selects how type URLs of google.protobuf.Any values are compared
+	typeURL string
+
+	// fieldMask selects how google.protobuf.FieldMask values are compared
+	fieldMask string
+
+	// method is the name of the generated method
+	method string
+
+	// diff enables generation of Diff methods
+	diff bool
+
+	// hash enables generation of Hash methods
+	hash bool
+
+	// compare enables generation of Compare methods
+	compare bool
+
+	// changedFields enables generation of ChangedFields methods
+	changedFields bool
+
+	// suffix is appended to the generated file name prefix
+	suffix string
+
+	// defaultMode selects whether methods are generated for messages
+	// without (equal.message) or (equal.file) options
+	defaultMode string
+}
+
+var params = defaultParameters()
+
+// defaultParameters returns the parameters used when none are passed.
+func defaultParameters() parameters {
+	return parameters{
+		unknown:     unknownCanonical,
+		float:       floatEqual,
+		time:        timeRaw,
+		any:         anyRaw,
+		typeURL:     typeURLExact,
+		fieldMask:   fieldMaskRaw,
+		method:      "Equal",
+		suffix:      "_equal",
+		defaultMode: defaultEnabled,
+	}
+}
+
+// paramSetters maps parameter names to functions validating and setting them.
+var paramSetters = map[string]func(p *parameters, value string) error{
+	"unknown": func(p *parameters, value string) error {
+		switch value {
+		case unknownIgnore, "false":
+			p.unknown = unknownIgnore
+		case unknownRaw, "true":
+			p.unknown = unknownRaw
+		case unknownCanonical:
+			p.unknown = unknownCanonical
+		default:
+			return fmt.Errorf("must be one of %s, %s or %s", unknownIgnore, unknownRaw, unknownCanonical)
+		}
+		return nil
+	},
+	"float": setFloat,
+	"nan":   setFloat, // former name of float
+	"time": func(p *parameters, value string) error {
+		switch value {
+		case timeRaw, timeNormalized:
+			p.time = value
+		default:
+			return fmt.Errorf("must be %s or %s", timeRaw, timeNormalized)
+		}
+		return nil
+	},
+	"any": func(p *parameters, value string) error {
+		switch value {
+		case anyRaw, anyUnpack:
+			p.any = value
+		default:
+			return fmt.Errorf("must be %s or %s", anyRaw, anyUnpack)
+		}
+		return nil
+	},
+	"type_url": func(p *parameters, value string) error {
+		switch value {
+		case typeURLExact, typeURLName:
+			p.typeURL = value
+		default:
+			return fmt.Errorf("must be %s or %s", typeURLExact, typeURLName)
+		}
+		return nil
+	},
+	"field_mask": func(p *parameters, value string) error {
+		switch value {
+		case fieldMaskRaw, fieldMaskNormalized:
+			p.fieldMask = value
+		default:
+			return fmt.Errorf("must be %s or %s", fieldMaskRaw, fieldMaskNormalized)
+		}
+		return nil
+	},
+	"default": func(p *parameters, value string) error {
+		switch value {
+		case defaultEnabled, defaultDisabled:
+			p.defaultMode = value
+		default:
+			return fmt.Errorf("must be %s or %s", defaultEnabled, defaultDisabled)
+		}
+		return nil
+	},
+	"diff": func(p *parameters, value string) error {
+		diff, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		p.diff = diff
+		return nil
+	},
+	"hash": func(p *parameters, value string) error {
+		hash, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		p.hash = hash
+		return nil
+	},
+	"compare": func(p *parameters, value string) error {
+		compare, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		p.compare = compare
+		return nil
+	},
+	"changed_fields": func(p *parameters, value string) error {
+		changedFields, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		p.changedFields = changedFields
+		return nil
+	},
+	"method": func(p *parameters, value string) error {
+		if !token.IsIdentifier(value) || !token.IsExported(value) {
+			return fmt.Errorf("must be an exported Go identifier")
+		}
+		if reservedMethods[value] {
+			return fmt.Errorf("must not be the name of another method of generated messages")
+		}
+		p.method = value
+		return nil
+	},
+	"suffix": func(p *parameters, value string) error {
+		if value == "" || strings.ContainsAny(value, `/\.`) {
+			return fmt.Errorf("must be a non-empty file name suffix without dots or path separators")
+		}
+		p.suffix = value
+		return nil
+	},
+}
+
+// reservedMethods are the methods of messages generated by protoc-gen-go and
+// by the other generators of this plugin, which method must not redeclare.
+var reservedMethods = map[string]bool{
+	"Reset":         true,
+	"String":        true,
+	"ProtoMessage":  true,
+	"ProtoReflect":  true,
+	"Descriptor":    true,
+	"Diff":          true,
+	"Hash":          true,
+	"Compare":       true,
+	"ChangedFields": true,
+}
+
+func setFloat(p *parameters, value string) error {
+	switch value {
+	case floatEqual, floatProto, floatBits:
+		p.float = value
+	default:
+		return fmt.Errorf("must be one of %s, %s or %s", floatEqual, floatProto, floatBits)
+	}
+	return nil
+}
+
+// set is used as protogen.Options.ParamFunc and sets a single parameter.
+func (p *parameters) set(name, value string) error {
+	setter, ok := paramSetters[name]
+	if !ok {
+		names := make([]string, 0, len(paramSetters))
+		for name := range paramSetters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown parameter %q, valid parameters are: %s", name, strings.Join(names, ", "))
+	}
+	if err := setter(p, value); err != nil {
+		return fmt.Errorf("invalid parameter %s=%q: %v", name, value, err)
+	}
+	return nil
+}
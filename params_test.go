@@ -0,0 +1,188 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParametersSet(t *testing.T) {
+	tests := []struct {
+		name, value string
+		want        func(p *parameters) // changes of the default parameters
+		err         string
+	}{
+		{
+			name:  "unknown",
+			value: "raw",
+			want:  func(p *parameters) { p.unknown = unknownRaw },
+		}, {
+			name:  "unknown",
+			value: "true",
+			want:  func(p *parameters) { p.unknown = unknownRaw },
+		}, {
+			name:  "unknown",
+			value: "false",
+			want:  func(p *parameters) { p.unknown = unknownIgnore },
+		}, {
+			name:  "unknown",
+			value: "yes",
+			err:   `invalid parameter unknown="yes"`,
+		}, {
+			name:  "float",
+			value: "proto",
+			want:  func(p *parameters) { p.float = floatProto },
+		}, {
+			name:  "float",
+			value: "bits",
+			want:  func(p *parameters) { p.float = floatBits },
+		}, {
+			name:  "float",
+			value: "nan",
+			err:   `invalid parameter float="nan"`,
+		}, {
+			name:  "nan",
+			value: "proto",
+			want:  func(p *parameters) { p.float = floatProto },
+		}, {
+			name:  "nan",
+			value: "true",
+			err:   `invalid parameter nan="true"`,
+		}, {
+			name:  "time",
+			value: "normalized",
+			want:  func(p *parameters) { p.time = timeNormalized },
+		}, {
+			name:  "time",
+			value: "utc",
+			err:   `invalid parameter time="utc"`,
+		}, {
+			name:  "any",
+			value: "unpack",
+			want:  func(p *parameters) { p.any = anyUnpack },
+		}, {
+			name:  "any",
+			value: "json",
+			err:   `invalid parameter any="json"`,
+		}, {
+			name:  "type_url",
+			value: "name",
+			want:  func(p *parameters) { p.typeURL = typeURLName },
+		}, {
+			name:  "type_url",
+			value: "prefix",
+			err:   `invalid parameter type_url="prefix"`,
+		}, {
+			name:  "field_mask",
+			value: "normalized",
+			want:  func(p *parameters) { p.fieldMask = fieldMaskNormalized },
+		}, {
+			name:  "field_mask",
+			value: "set",
+			err:   `invalid parameter field_mask="set"`,
+		}, {
+			name:  "default",
+			value: "disabled",
+			want:  func(p *parameters) { p.defaultMode = defaultDisabled },
+		}, {
+			name:  "default",
+			value: "off",
+			err:   `invalid parameter default="off"`,
+		}, {
+			name:  "diff",
+			value: "true",
+			want:  func(p *parameters) { p.diff = true },
+		}, {
+			name:  "diff",
+			value: "maybe",
+			err:   `invalid parameter diff="maybe"`,
+		}, {
+			name:  "hash",
+			value: "true",
+			want:  func(p *parameters) { p.hash = true },
+		}, {
+			name:  "compare",
+			value: "true",
+			want:  func(p *parameters) { p.compare = true },
+		}, {
+			name:  "changed_fields",
+			value: "true",
+			want:  func(p *parameters) { p.changedFields = true },
+		}, {
+			name:  "method",
+			value: "EqualVT",
+			want:  func(p *parameters) { p.method = "EqualVT" },
+		}, {
+			name:  "method",
+			value: "equal",
+			err:   `invalid parameter method="equal"`,
+		}, {
+			name:  "method",
+			value: "Diff",
+			err:   `invalid parameter method="Diff"`,
+		}, {
+			name:  "method",
+			value: "Hash",
+			err:   `invalid parameter method="Hash"`,
+		}, {
+			name:  "method",
+			value: "Compare",
+			err:   `invalid parameter method="Compare"`,
+		}, {
+			name:  "method",
+			value: "ChangedFields",
+			err:   `invalid parameter method="ChangedFields"`,
+		}, {
+			name:  "method",
+			value: "String",
+			err:   `invalid parameter method="String"`,
+		}, {
+			name:  "method",
+			value: "Reset",
+			err:   `invalid parameter method="Reset"`,
+		}, {
+			name:  "method",
+			value: "ProtoReflect",
+			err:   `invalid parameter method="ProtoReflect"`,
+		}, {
+			name:  "method",
+			value: "ProtoMessage",
+			err:   `invalid parameter method="ProtoMessage"`,
+		}, {
+			name:  "method",
+			value: "Descriptor",
+			err:   `invalid parameter method="Descriptor"`,
+		}, {
+			name:  "suffix",
+			value: "_eq",
+			want:  func(p *parameters) { p.suffix = "_eq" },
+		}, {
+			name:  "suffix",
+			value: "../eq",
+			err:   `invalid parameter suffix="../eq"`,
+		}, {
+			name:  "nope",
+			value: "1",
+			err:   `unknown parameter "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		p := defaultParameters()
+		err := p.set(tt.name, tt.value)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("set(%q, %q) error = %v, want %q", tt.name, tt.value, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("set(%q, %q) error = %v", tt.name, tt.value, err)
+			continue
+		}
+		want := defaultParameters()
+		tt.want(&want)
+		if p != want {
+			t.Errorf("set(%q, %q) = %+v, want %+v", tt.name, tt.value, p, want)
+		}
+	}
+}